@@ -0,0 +1,51 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// AABB is an axis-aligned bounding box, described by its minimum and maximum corners.
+// A box is considered valid if every element of Min is no greater than the
+// corresponding element of Max.
+type AABB struct {
+	Min, Max Vec3
+}
+
+// Center returns the point in the middle of the box.
+func (b AABB) Center() Vec3 {
+	return b.Min.Add(b.Max).Mul(0.5)
+}
+
+// Extents returns the half-size of the box along each axis, that is, the vector
+// from the center to the Max corner.
+func (b AABB) Extents() Vec3 {
+	return b.Max.Sub(b.Min).Mul(0.5)
+}
+
+// Transform returns the tightest axis-aligned box enclosing b after it has been
+// transformed by m. Rather than transforming all eight corners, each output axis is
+// accumulated from the minimum and maximum of the matrix elements multiplied by
+// the box bounds (Arvo's method), which gives the same result more cheaply.
+//
+// The matrix is assumed to be affine; the bottom row is ignored, so projections will
+// not give meaningful results.
+func (b AABB) Transform(m Mat4) AABB {
+	var result AABB
+	for i := 0; i < 3; i++ {
+		result.Min[i] = m.At(i, 3)
+		result.Max[i] = m.At(i, 3)
+
+		for j := 0; j < 3; j++ {
+			e, f := m.At(i, j)*b.Min[j], m.At(i, j)*b.Max[j]
+			if e < f {
+				result.Min[i] += e
+				result.Max[i] += f
+			} else {
+				result.Min[i] += f
+				result.Max[i] += e
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,67 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+// transformAABBCorners is the brute force version of AABB.Transform,
+// transforming all eight corners and taking their bounds.
+func transformAABBCorners(b AABB, m Mat4) AABB {
+	result := AABB{Min: Vec3{InfPos, InfPos, InfPos}, Max: Vec3{InfNeg, InfNeg, InfNeg}}
+	for i := 0; i < 8; i++ {
+		corner := b.Min
+		for j := 0; j < 3; j++ {
+			if i&(1<<uint(j)) != 0 {
+				corner[j] = b.Max[j]
+			}
+		}
+
+		p := m.Mul4x1(corner.Vec4(1)).Vec3()
+		for j := 0; j < 3; j++ {
+			SetMin(&result.Min[j], &p[j])
+			SetMax(&result.Max[j], &p[j])
+		}
+	}
+
+	return result
+}
+
+func TestAABBTransform(t *testing.T) {
+	box := AABB{Min: Vec3{-1, 0, 2}, Max: Vec3{3, 1, 5}}
+
+	tests := []struct {
+		Description string
+		M           Mat4
+	}{
+		{"identity", Ident4()},
+		{"translation", Translate3D(1, -2, 3)},
+		{"scale", Scale3D(2, -1, 0.5)},
+		{"rotation", HomogRotate3D(math.Pi/5, Vec3{1, 1, 0}.Normalize())},
+		{"combined", Translate3D(4, 5, 6).Mul4(HomogRotate3DY(1)).Mul4(Scale3D(1, 2, 3))},
+	}
+
+	for _, c := range tests {
+		expected := transformAABBCorners(box, c.M)
+		r := box.Transform(c.M)
+		if !r.Min.EqualThreshold(expected.Min, 1e-4) || !r.Max.EqualThreshold(expected.Max, 1e-4) {
+			t.Errorf("%v failed: %v.Transform(%v) != %v (got %v)", c.Description, box, c.M, expected, r)
+		}
+	}
+}
+
+func TestAABBCenterExtents(t *testing.T) {
+	box := AABB{Min: Vec3{-1, 0, 2}, Max: Vec3{3, 1, 5}}
+
+	if c := box.Center(); c != (Vec3{1, 0.5, 3.5}) {
+		t.Errorf("%v.Center() != %v (got %v)", box, Vec3{1, 0.5, 3.5}, c)
+	}
+
+	if e := box.Extents(); e != (Vec3{2, 0.5, 1.5}) {
+		t.Errorf("%v.Extents() != %v (got %v)", box, Vec3{2, 0.5, 1.5}, e)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// DotBatch computes the pairwise dot products of a and b, storing
+// a[i].Dot(b[i]) in dst[i].
+//
+// All three slices must have the same length or this function will panic.
+// The loop is written with the slices resliced to a common length up front,
+// so the compiler can eliminate the bounds checks in the body.
+func DotBatch(dst []float32, a, b []Vec3) {
+	if len(a) != len(dst) || len(b) != len(dst) {
+		panic("DotBatch: dst, a, and b must have the same length")
+	}
+
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i][0]*b[i][0] + a[i][1]*b[i][1] + a[i][2]*b[i][2]
+	}
+}
+
+// CrossBatch computes the pairwise cross products of a and b, storing
+// a[i].Cross(b[i]) in dst[i].
+//
+// All three slices must have the same length or this function will panic.
+// dst may be the same slice as a or b.
+func CrossBatch(dst, a, b []Vec3) {
+	if len(a) != len(dst) || len(b) != len(dst) {
+		panic("CrossBatch: dst, a, and b must have the same length")
+	}
+
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		a0, a1, a2 := a[i][0], a[i][1], a[i][2]
+		b0, b1, b2 := b[i][0], b[i][1], b[i][2]
+		dst[i] = Vec3{a1*b2 - a2*b1, a2*b0 - a0*b2, a0*b1 - a1*b0}
+	}
+}
+
+// TransformPointsPacked transforms each point of src by m and writes the results as
+// consecutive x, y, z triples into dst, ready to upload to a vertex buffer. Point i is
+// written to dst[3*i : 3*i+3]; any remaining elements of dst are left untouched.
+//
+// m is assumed to be affine: the bottom row is ignored and no perspective divide is
+// performed. dst must have room for 3*len(src) elements or this function will panic.
+func (m Mat4) TransformPointsPacked(dst []float32, src []Vec3) {
+	if len(dst) < 3*len(src) {
+		panic("TransformPointsPacked: dst must hold at least 3*len(src) elements")
+	}
+
+	dst = dst[:3*len(src)]
+	for i, p := range src {
+		x, y, z := p[0], p[1], p[2]
+		d := dst[3*i : 3*i+3]
+		d[0] = m[0]*x + m[4]*y + m[8]*z + m[12]
+		d[1] = m[1]*x + m[5]*y + m[9]*z + m[13]
+		d[2] = m[2]*x + m[6]*y + m[10]*z + m[14]
+	}
+}
+
+// Vec3Array stores a sequence of Vec3s as a structure of arrays: element i is
+// Vec3{X[i], Y[i], Z[i]}. Keeping each component contiguous lets bulk
+// operations such as particle updates stream through memory and gives the
+// compiler simple loops to work with.
+//
+// X, Y, and Z must always have the same length; the methods below assume so.
+type Vec3Array struct {
+	X, Y, Z []float32
+}
+
+// NewVec3Array returns a Vec3Array of n zero vectors.
+func NewVec3Array(n int) Vec3Array {
+	return Vec3Array{make([]float32, n), make([]float32, n), make([]float32, n)}
+}
+
+// Vec3ArrayFromSlice copies vs into a new Vec3Array.
+func Vec3ArrayFromSlice(vs []Vec3) Vec3Array {
+	a := NewVec3Array(len(vs))
+	x, y, z := a.X[:len(vs)], a.Y[:len(vs)], a.Z[:len(vs)]
+	for i, v := range vs {
+		x[i], y[i], z[i] = v[0], v[1], v[2]
+	}
+
+	return a
+}
+
+// Len returns the number of vectors in the array.
+func (a Vec3Array) Len() int {
+	return len(a.X)
+}
+
+// At returns the vector at index i.
+func (a Vec3Array) At(i int) Vec3 {
+	return Vec3{a.X[i], a.Y[i], a.Z[i]}
+}
+
+// Set sets the vector at index i to v.
+func (a Vec3Array) Set(i int, v Vec3) {
+	a.X[i], a.Y[i], a.Z[i] = v[0], v[1], v[2]
+}
+
+// ToSlice copies the array into a new []Vec3.
+func (a Vec3Array) ToSlice() []Vec3 {
+	vs := make([]Vec3, len(a.X))
+	y, z := a.Y[:len(vs)], a.Z[:len(vs)]
+	for i, x := range a.X {
+		vs[i] = Vec3{x, y[i], z[i]}
+	}
+
+	return vs
+}
+
+// AddScaled adds other scaled by s to a in place, so that afterwards
+// a.At(i) is the old a.At(i).Add(other.At(i).Mul(s)). This is the usual
+// "position += velocity * dt" step of a particle update.
+//
+// Both arrays must have the same length or this function will panic.
+func (a Vec3Array) AddScaled(other Vec3Array, s float32) {
+	n := len(a.X)
+	if len(other.X) != n {
+		panic("Vec3Array.AddScaled: arrays must have the same length")
+	}
+
+	addScaled(a.X[:n], other.X[:n], s)
+	addScaled(a.Y[:n], other.Y[:n], s)
+	addScaled(a.Z[:n], other.Z[:n], s)
+}
+
+func addScaled(dst, src []float32, s float32) {
+	src = src[:len(dst)]
+	for i := range dst {
+		dst[i] += src[i] * s
+	}
+}
+
+// Dot returns a new slice holding the pairwise dot products a.At(i).Dot(other.At(i)).
+//
+// Both arrays must have the same length or this function will panic.
+func (a Vec3Array) Dot(other Vec3Array) []float32 {
+	n := len(a.X)
+	if len(other.X) != n {
+		panic("Vec3Array.Dot: arrays must have the same length")
+	}
+
+	dst := make([]float32, n)
+	ax, ay, az := a.X[:n], a.Y[:n], a.Z[:n]
+	bx, by, bz := other.X[:n], other.Y[:n], other.Z[:n]
+	for i := range dst {
+		dst[i] = ax[i]*bx[i] + ay[i]*by[i] + az[i]*bz[i]
+	}
+
+	return dst
+}
@@ -0,0 +1,283 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func randVec3Slice(r *rand.Rand, n int) []Vec3 {
+	s := make([]Vec3, n)
+	for i := range s {
+		s[i] = Vec3{r.Float32()*2 - 1, r.Float32()*2 - 1, r.Float32()*2 - 1}
+	}
+	return s
+}
+
+func TestDotBatch(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	a, b := randVec3Slice(r, 37), randVec3Slice(r, 37)
+	dst := make([]float32, 37)
+
+	DotBatch(dst, a, b)
+
+	for i := range dst {
+		if e := a[i].Dot(b[i]); !FloatEqualThreshold(dst[i], e, 1e-6) {
+			t.Errorf("DotBatch element %d != %v (got %v)", i, e, dst[i])
+		}
+	}
+}
+
+func TestCrossBatch(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	a, b := randVec3Slice(r, 37), randVec3Slice(r, 37)
+	dst := make([]Vec3, 37)
+
+	CrossBatch(dst, a, b)
+
+	for i := range dst {
+		if e := a[i].Cross(b[i]); !dst[i].ApproxEqualThreshold(e, 1e-6) {
+			t.Errorf("CrossBatch element %d != %v (got %v)", i, e, dst[i])
+		}
+	}
+
+	// dst aliasing a
+	expected := append([]Vec3{}, dst...)
+	CrossBatch(a, a, b)
+	for i := range a {
+		if e := expected[i]; !a[i].ApproxEqualThreshold(e, 1e-6) {
+			t.Errorf("CrossBatch with aliased dst element %d != %v (got %v)", i, e, a[i])
+		}
+	}
+}
+
+func TestBatchLengthMismatch(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("DotBatch with mismatched lengths did not panic")
+		}
+	}()
+
+	DotBatch(make([]float32, 2), make([]Vec3, 2), make([]Vec3, 3))
+}
+
+func BenchmarkDotBatch(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	v1, v2 := randVec3Slice(r, 1024), randVec3Slice(r, 1024)
+	dst := make([]float32, 1024)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		DotBatch(dst, v1, v2)
+	}
+}
+
+func BenchmarkDotLoop(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	v1, v2 := randVec3Slice(r, 1024), randVec3Slice(r, 1024)
+	dst := make([]float32, 1024)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j := range dst {
+			dst[j] = v1[j].Dot(v2[j])
+		}
+	}
+}
+
+func BenchmarkCrossBatch(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	v1, v2 := randVec3Slice(r, 1024), randVec3Slice(r, 1024)
+	dst := make([]Vec3, 1024)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		CrossBatch(dst, v1, v2)
+	}
+}
+
+func BenchmarkCrossLoop(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	v1, v2 := randVec3Slice(r, 1024), randVec3Slice(r, 1024)
+	dst := make([]Vec3, 1024)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j := range dst {
+			dst[j] = v1[j].Cross(v2[j])
+		}
+	}
+}
+
+func TestTransformPointsPacked(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	m := RandomAffineMat4(r)
+	src := randVec3Slice(r, 37)
+	dst := make([]float32, 3*len(src)+1)
+	dst[len(dst)-1] = 42
+
+	m.TransformPointsPacked(dst, src)
+
+	for i, p := range src {
+		e := m.Mul4x1(p.Vec4(1)).Vec3()
+		if r := (Vec3{dst[3*i], dst[3*i+1], dst[3*i+2]}); !r.EqualThreshold(e, 1e-5) {
+			t.Errorf("TransformPointsPacked point %d != %v (got %v)", i, e, r)
+		}
+	}
+
+	if dst[len(dst)-1] != 42 {
+		t.Errorf("TransformPointsPacked wrote past 3*len(src)")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TransformPointsPacked with a short dst did not panic")
+		}
+	}()
+	m.TransformPointsPacked(make([]float32, 3*len(src)-1), src)
+}
+
+func BenchmarkTransformPointsPacked(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	m := RandomAffineMat4(r)
+	src := randVec3Slice(r, 1024)
+	dst := make([]float32, 3*len(src))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.TransformPointsPacked(dst, src)
+	}
+}
+
+func BenchmarkTransformPointsLoop(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	m := RandomAffineMat4(r)
+	src := randVec3Slice(r, 1024)
+	dst := make([]float32, 3*len(src))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j, p := range src {
+			v := m.Mul4x1(p.Vec4(1))
+			dst[3*j], dst[3*j+1], dst[3*j+2] = v[0], v[1], v[2]
+		}
+	}
+}
+
+func TestVec3ArrayConversion(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	vs := randVec3Slice(r, 37)
+
+	a := Vec3ArrayFromSlice(vs)
+	if a.Len() != len(vs) {
+		t.Fatalf("Vec3ArrayFromSlice length != %d (got %d)", len(vs), a.Len())
+	}
+
+	for i, v := range vs {
+		if a.At(i) != v {
+			t.Errorf("Vec3Array.At(%d) != %v (got %v)", i, v, a.At(i))
+		}
+	}
+
+	back := a.ToSlice()
+	for i, v := range vs {
+		if back[i] != v {
+			t.Errorf("Vec3Array.ToSlice element %d != %v (got %v)", i, v, back[i])
+		}
+	}
+
+	a.Set(3, Vec3{1, 2, 3})
+	if a.X[3] != 1 || a.Y[3] != 2 || a.Z[3] != 3 {
+		t.Errorf("Vec3Array.Set(3, [1 2 3]) stored %v", a.At(3))
+	}
+}
+
+func TestVec3ArrayAddScaled(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	pos, vel := randVec3Slice(r, 37), randVec3Slice(r, 37)
+
+	a := Vec3ArrayFromSlice(pos)
+	a.AddScaled(Vec3ArrayFromSlice(vel), 0.25)
+
+	for i := range pos {
+		if e := pos[i].Add(vel[i].Mul(0.25)); !a.At(i).EqualThreshold(e, 1e-6) {
+			t.Errorf("Vec3Array.AddScaled element %d != %v (got %v)", i, e, a.At(i))
+		}
+	}
+}
+
+func TestVec3ArrayDot(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	v1, v2 := randVec3Slice(r, 37), randVec3Slice(r, 37)
+
+	dots := Vec3ArrayFromSlice(v1).Dot(Vec3ArrayFromSlice(v2))
+	for i := range v1 {
+		if e := v1[i].Dot(v2[i]); !FloatEqualThreshold(dots[i], e, 1e-6) {
+			t.Errorf("Vec3Array.Dot element %d != %v (got %v)", i, e, dots[i])
+		}
+	}
+}
+
+func TestVec3ArrayLengthMismatch(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Vec3Array.AddScaled with mismatched lengths did not panic")
+		}
+	}()
+
+	NewVec3Array(2).AddScaled(NewVec3Array(3), 1)
+}
+
+func BenchmarkVec3ArrayAddScaled(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	pos := Vec3ArrayFromSlice(randVec3Slice(r, 1024))
+	vel := Vec3ArrayFromSlice(randVec3Slice(r, 1024))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		pos.AddScaled(vel, 1.0/60)
+	}
+}
+
+func BenchmarkVec3SliceAddScaled(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	pos, vel := randVec3Slice(r, 1024), randVec3Slice(r, 1024)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j := range pos {
+			pos[j] = pos[j].Add(vel[j].Mul(1.0 / 60))
+		}
+	}
+}
+
+func BenchmarkVec3ArrayDot(b *testing.B) {
+	r := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+	v1 := Vec3ArrayFromSlice(randVec3Slice(r, 1024))
+	v2 := Vec3ArrayFromSlice(randVec3Slice(r, 1024))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		v1.Dot(v2)
+	}
+}
@@ -44,6 +44,13 @@ var mgl64RewriteRules = []string{
 	"math.SmallestNonzeroFloat32 -> math.SmallestNonzeroFloat64",
 }
 
+// mgl32Only lists the files that are not copied to mgl64, because they deal with
+// float32 specifically (such as conversions to and from half precision).
+var mgl32Only = map[string]bool{
+	"half.go":      true,
+	"half_test.go": true,
+}
+
 func main() {
 	flag.Usage = func() {
 		fmt.Println("Usage: codegen -template file.tmpl -output file.go")
@@ -110,7 +117,7 @@ func genMgl64(destPath string) {
 		if info.IsDir() {
 			return os.MkdirAll(dest, info.Mode())
 		}
-		if !strings.HasSuffix(source, ".go") || info.Name() == "codegen.go" {
+		if !strings.HasSuffix(source, ".go") || info.Name() == "codegen.go" || mgl32Only[source] {
 			return nil
 		}
 		if !info.Mode().IsRegular() {
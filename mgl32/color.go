@@ -0,0 +1,35 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// The functions in this file treat a Vec4 as an RGBA color, with the color
+// channels in X, Y, and Z and the alpha (opacity) in W. Conversions to and from
+// the types of the image/color package are in the mglcolor subpackage.
+
+// Premultiply returns the color with its red, green, and blue channels
+// multiplied by its alpha. Premultiplied colors blend and filter correctly
+// with the common "one, one minus source alpha" blend function.
+func (v Vec4) Premultiply() Vec4 {
+	return Vec4{v[0] * v[3], v[1] * v[3], v[2] * v[3], v[3]}
+}
+
+// Unpremultiply reverses Premultiply, dividing the red, green, and blue
+// channels by the alpha. A fully transparent color carries no color
+// information once premultiplied, so if the alpha is 0 the result is
+// transparent black rather than a division by zero.
+func (v Vec4) Unpremultiply() Vec4 {
+	if v[3] == 0 {
+		return Vec4{}
+	}
+
+	inv := 1 / v[3]
+	return Vec4{v[0] * inv, v[1] * inv, v[2] * inv, v[3]}
+}
+
+// ClampColor clamps every channel of the color, including alpha, to the
+// range [0,1]. It is the same as Saturate.
+func (v Vec4) ClampColor() Vec4 {
+	return v.Saturate()
+}
@@ -0,0 +1,55 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestPremultiply(t *testing.T) {
+	tests := []struct {
+		Color, Expected Vec4
+	}{
+		{Vec4{1, 0.5, 0.25, 1}, Vec4{1, 0.5, 0.25, 1}},
+		{Vec4{1, 0.5, 0.25, 0.5}, Vec4{0.5, 0.25, 0.125, 0.5}},
+		{Vec4{1, 0.5, 0.25, 0}, Vec4{0, 0, 0, 0}},
+	}
+
+	for _, c := range tests {
+		if r := c.Color.Premultiply(); !r.EqualThreshold(c.Expected, 1e-6) {
+			t.Errorf("%v.Premultiply() != %v (got %v)", c.Color, c.Expected, r)
+		}
+	}
+}
+
+func TestUnpremultiply(t *testing.T) {
+	// Round trip at alpha 0.5
+	for _, color := range []Vec4{{1, 0.5, 0.25, 0.5}, {0, 0.3, 0.9, 0.5}} {
+		if r := color.Premultiply().Unpremultiply(); !r.EqualThreshold(color, 1e-6) {
+			t.Errorf("%v.Premultiply().Unpremultiply() != %v (got %v)", color, color, r)
+		}
+	}
+
+	// Alpha 0 has no color left to recover, and must not produce NaNs
+	color := Vec4{1, 0.5, 0.25, 0}
+	if r := color.Premultiply().Unpremultiply(); r != (Vec4{}) {
+		t.Errorf("%v.Premultiply().Unpremultiply() != %v (got %v)", color, Vec4{}, r)
+	}
+}
+
+func TestClampColor(t *testing.T) {
+	tests := []struct {
+		Color, Expected Vec4
+	}{
+		{Vec4{0.2, 0.4, 0.6, 0.8}, Vec4{0.2, 0.4, 0.6, 0.8}},
+		{Vec4{-1, 2, 0.5, 1.5}, Vec4{0, 1, 0.5, 1}},
+	}
+
+	for _, c := range tests {
+		if r := c.Color.ClampColor(); r != c.Expected {
+			t.Errorf("%v.ClampColor() != %v (got %v)", c.Color, c.Expected, r)
+		}
+	}
+}
@@ -92,3 +92,93 @@ func DegToRad(angle float32) float32 {
 func RadToDeg(angle float32) float32 {
 	return angle * 180 / float32(math.Pi)
 }
+
+// WrapAngle2Pi maps an angle in radians to the equivalent angle in the range [0, 2*Pi).
+func WrapAngle2Pi(angle float32) float32 {
+	r := float32(math.Mod(float64(angle), 2*math.Pi))
+	if r < 0 {
+		r += 2 * math.Pi
+	}
+
+	// Adding 2*Pi to a tiny negative value can round up to exactly 2*Pi
+	if r >= 2*math.Pi {
+		r = 0
+	}
+
+	return r
+}
+
+// WrapAngle maps an angle in radians to the equivalent angle in the range (-Pi, Pi].
+func WrapAngle(angle float32) float32 {
+	r := WrapAngle2Pi(angle)
+	if r > math.Pi {
+		r -= 2 * math.Pi
+	}
+
+	return r
+}
+
+// AngleDifference returns the shortest signed angle from b to a, in radians, in
+// the range (-Pi, Pi]. That is, b + AngleDifference(a, b) is equivalent to a, and
+// the result is positive if a is counterclockwise of b.
+func AngleDifference(a, b float32) float32 {
+	return WrapAngle(a - b)
+}
+
+// EncodeOct maps the unit vector v onto the square [-1,1]x[-1,1] using the octahedral
+// mapping, which is commonly used to store normals compactly (e.g. in two 16 or even
+// 8 bit channels). The vector is projected onto the octahedron |x|+|y|+|z| = 1, and
+// the lower half (z < 0) is folded out over the corners of the square.
+//
+// v is assumed to be normalized. Use DecodeOct to recover it.
+func (v Vec3) EncodeOct() Vec2 {
+	l := Abs(v[0]) + Abs(v[1]) + Abs(v[2])
+	p := Vec2{v[0] / l, v[1] / l}
+
+	if v[2] < 0 {
+		p = Vec2{(1 - Abs(p[1])) * signNotZero(p[0]), (1 - Abs(p[0])) * signNotZero(p[1])}
+	}
+
+	return p
+}
+
+// DecodeOct is the inverse of Vec3.EncodeOct, returning the unit vector encoded as the
+// point e in [-1,1]x[-1,1]. Points outside of that square are not valid encodings.
+func DecodeOct(e Vec2) Vec3 {
+	v := Vec3{e[0], e[1], 1 - Abs(e[0]) - Abs(e[1])}
+
+	if v[2] < 0 {
+		v[0], v[1] = (1-Abs(e[1]))*signNotZero(e[0]), (1-Abs(e[0]))*signNotZero(e[1])
+	}
+
+	return v.Normalize()
+}
+
+// QuantizeDirection returns the unit vector v as it would be after being stored with
+// EncodeOct in two signed normalized integers of the given number of bits each, and
+// decoded again. Snapping every normal of a mesh this way before compressing it makes
+// normals that were equal stay equal, and quantizing a vector that was already
+// quantized at the same depth returns it unchanged.
+//
+// v is assumed to be normalized. bits must be between 2 and 16, or this will panic.
+func (v Vec3) QuantizeDirection(bits int) Vec3 {
+	if bits < 2 || bits > 16 {
+		panic("QuantizeDirection: bits must be between 2 and 16")
+	}
+
+	max := float64(int(1)<<uint(bits-1) - 1)
+	e := v.EncodeOct()
+	for i := range e {
+		e[i] = float32(math.Round(float64(e[i])*max) / max)
+	}
+
+	return DecodeOct(e)
+}
+
+// signNotZero returns 1 for non-negative values and -1 for negative ones.
+func signNotZero(a float32) float32 {
+	if a < 0 {
+		return -1
+	}
+	return 1
+}
@@ -6,6 +6,7 @@ package mgl32
 
 import (
 	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -148,3 +149,115 @@ func TestRadToDeg(t *testing.T) {
 		}
 	}
 }
+
+func TestWrapAngle(t *testing.T) {
+	tests := []struct {
+		Angle, Wrapped, Wrapped2Pi float32
+	}{
+		{0, 0, 0},
+		{1, 1, 1},
+		{-1, -1, 2*math.Pi - 1},
+		{math.Pi, math.Pi, math.Pi},
+		{-math.Pi, math.Pi, math.Pi},
+		{3 * math.Pi / 2, -math.Pi / 2, 3 * math.Pi / 2},
+		{2 * math.Pi, 0, 0},
+		{5*math.Pi + 0.5, -math.Pi + 0.5, math.Pi + 0.5},
+		{-7*math.Pi - 0.25, math.Pi - 0.25, math.Pi - 0.25},
+		{100, 100 - 32*math.Pi, 100 - 30*math.Pi},
+	}
+
+	eq := absEqual(1e-4)
+	for _, c := range tests {
+		if r := WrapAngle(c.Angle); !eq(r, c.Wrapped) || r <= -math.Pi || r > math.Pi {
+			t.Errorf("WrapAngle(%v) != %v (got %v)", c.Angle, c.Wrapped, r)
+		}
+		if r := WrapAngle2Pi(c.Angle); !eq(r, c.Wrapped2Pi) || r < 0 || r >= 2*math.Pi {
+			t.Errorf("WrapAngle2Pi(%v) != %v (got %v)", c.Angle, c.Wrapped2Pi, r)
+		}
+	}
+}
+
+func TestAngleDifference(t *testing.T) {
+	tests := []struct {
+		A, B, Expected float32
+	}{
+		{1, 0.5, 0.5},
+		{0.5, 1, -0.5},
+		{0.1, 2*math.Pi - 0.1, 0.2},
+		{2*math.Pi - 0.1, 0.1, -0.2},
+		{-3, 3, 2*math.Pi - 6},
+		{10 * math.Pi, 0, 0},
+	}
+
+	eq := absEqual(1e-4)
+	for _, c := range tests {
+		if r := AngleDifference(c.A, c.B); !eq(r, c.Expected) {
+			t.Errorf("AngleDifference(%v, %v) != %v (got %v)", c.A, c.B, c.Expected, r)
+		}
+	}
+}
+
+func TestOctEncoding(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+
+	axes := []Vec3{{1, 0, 0}, {-1, 0, 0}, {0, 1, 0}, {0, -1, 0}, {0, 0, 1}, {0, 0, -1}}
+	for _, v := range axes {
+		if d := DecodeOct(v.EncodeOct()); !d.EqualThreshold(v, 1e-6) {
+			t.Errorf("DecodeOct(%v.EncodeOct()) != itself (got %v)", v, d)
+		}
+	}
+
+	// Cosine of the largest angular error allowed, 0.1 degrees
+	minCos := float32(math.Cos(0.1 * math.Pi / 180))
+	for i := 0; i < 1000; i++ {
+		v := RandomVec3(r, 1)
+		if v.Len() < 1e-3 {
+			continue
+		}
+		v = v.Normalize()
+
+		e := v.EncodeOct()
+		if e.LenChebyshev() > 1 {
+			t.Errorf("%v.EncodeOct() = %v, outside [-1,1]^2", v, e)
+		}
+
+		d := DecodeOct(e)
+		if !FloatEqualThreshold(d.Len(), 1, 1e-5) {
+			t.Errorf("DecodeOct(%v) is not normalized (got %v)", e, d)
+		}
+		if d.Dot(v) < minCos {
+			t.Errorf("DecodeOct(%v.EncodeOct()) = %v, too far from the original", v, d)
+		}
+	}
+}
+
+func TestQuantizeDirection(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+
+	for _, bits := range []int{4, 8, 12, 16} {
+		// The largest angular error grows with the grid spacing, 2/(2^(bits-1)-1). It
+		// is compared against the sine of the angle, as its cosine rounds to 1.
+		maxSin := float32(4 / float64(int(1)<<uint(bits-1)-1))
+		for i := 0; i < 200; i++ {
+			v := RandomVec3(r, 1)
+			if v.Len() < 1e-3 {
+				continue
+			}
+			v = v.Normalize()
+
+			q := v.QuantizeDirection(bits)
+			if q2 := q.QuantizeDirection(bits); q2 != q {
+				t.Errorf("%v.QuantizeDirection(%v) is not idempotent: %v then %v", v, bits, q, q2)
+			}
+			if q.Cross(v).Len() > maxSin {
+				t.Errorf("%v.QuantizeDirection(%v) = %v, too far from the original", v, bits, q)
+			}
+		}
+	}
+
+	for _, v := range []Vec3{{1, 0, 0}, {0, -1, 0}, {0, 0, 1}, {0, 0, -1}} {
+		if q := v.QuantizeDirection(8); !q.EqualThreshold(v, 1e-6) {
+			t.Errorf("%v.QuantizeDirection(8) != itself (got %v)", v, q)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Culling is the result of testing a volume against a ViewFrustum.
+type Culling int
+
+const (
+	// The volume is entirely outside the frustum.
+	CullOutside Culling = iota
+	// The volume is entirely inside the frustum.
+	CullInside
+	// The volume straddles at least one of the frustum's planes. It may or may
+	// not actually be visible.
+	CullIntersecting
+)
+
+// A ViewFrustum is a convex volume bounded by six planes, usually the view volume
+// of a camera. (It is not called Frustum since that name is taken by the function
+// that builds a perspective projection matrix.) Each plane is stored as (a, b, c, d)
+// such that ax + by + cz + d = 0 on the plane, with the normal (a, b, c) normalized
+// and pointing into the frustum.
+//
+// The planes are in the order left, right, bottom, top, near, far.
+type ViewFrustum struct {
+	Planes [6]Vec4
+}
+
+// ViewFrustumFromMatrix extracts the planes of the view volume of a view-projection
+// matrix (projection.Mul4(view)), using the Gribb-Hartmann method. The resulting
+// frustum is in world space. If m is only a projection matrix, the frustum is in
+// eye space, and if it's a full model-view-projection matrix, it's in object space.
+func ViewFrustumFromMatrix(m Mat4) ViewFrustum {
+	r0, r1, r2, r3 := m.Rows()
+
+	f := ViewFrustum{Planes: [6]Vec4{
+		r3.Add(r0),
+		r3.Sub(r0),
+		r3.Add(r1),
+		r3.Sub(r1),
+		r3.Add(r2),
+		r3.Sub(r2),
+	}}
+
+	for i, p := range f.Planes {
+		f.Planes[i] = p.Mul(1 / p.Vec3().Len())
+	}
+
+	return f
+}
+
+// TestAABB tests an axis-aligned box against the frustum. Unlike a plain visible
+// or not visible test, this distinguishes boxes entirely inside the frustum from
+// those that straddle its boundary, so a hierarchical culler can stop testing the
+// children of a box that's fully inside.
+//
+// The test is conservative: a box near a corner of the frustum may be reported as
+// CullIntersecting even though it is actually outside.
+func (f ViewFrustum) TestAABB(box AABB) Culling {
+	center, extents := box.Center(), box.Extents()
+
+	result := CullInside
+	for _, p := range f.Planes {
+		n := p.Vec3()
+		dist := n.Dot(center) + p[3]
+		radius := Abs(n[0])*extents[0] + Abs(n[1])*extents[1] + Abs(n[2])*extents[2]
+
+		if dist+radius < 0 {
+			return CullOutside
+		}
+		if dist-radius < 0 {
+			result = CullIntersecting
+		}
+	}
+
+	return result
+}
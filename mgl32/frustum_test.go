@@ -0,0 +1,54 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestViewFrustumFromMatrix(t *testing.T) {
+	f := ViewFrustumFromMatrix(Ortho(-1, 1, -2, 2, 1, 10))
+
+	// Looking down -Z, so near is at z=-1 and far at z=-10
+	expected := [6]Vec4{
+		{1, 0, 0, 1},
+		{-1, 0, 0, 1},
+		{0, 1, 0, 2},
+		{0, -1, 0, 2},
+		{0, 0, -1, -1},
+		{0, 0, 1, 10},
+	}
+
+	for i := range expected {
+		if !f.Planes[i].EqualThreshold(expected[i], 1e-5) {
+			t.Errorf("ViewFrustumFromMatrix plane %d != %v (got %v)", i, expected[i], f.Planes[i])
+		}
+	}
+}
+
+func TestViewFrustumTestAABB(t *testing.T) {
+	view := LookAtV(Vec3{0, 0, 5}, Vec3{0, 0, 0}, Vec3{0, 1, 0})
+	f := ViewFrustumFromMatrix(Perspective(DegToRad(90), 1, 1, 100).Mul4(view))
+
+	tests := []struct {
+		Description string
+		Box         AABB
+		Expected    Culling
+	}{
+		{"inside", AABB{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}, CullInside},
+		{"outside behind", AABB{Vec3{-1, -1, 6}, Vec3{1, 1, 8}}, CullOutside},
+		{"outside left", AABB{Vec3{-50, -1, -1}, Vec3{-40, 1, 1}}, CullOutside},
+		{"outside beyond far", AABB{Vec3{-1, -1, -200}, Vec3{1, 1, -150}}, CullOutside},
+		{"straddling near", AABB{Vec3{-1, -1, 3}, Vec3{1, 1, 4.5}}, CullIntersecting},
+		{"straddling right", AABB{Vec3{4, -1, -1}, Vec3{8, 1, 1}}, CullIntersecting},
+		{"containing", AABB{Vec3{-500, -500, -500}, Vec3{500, 500, 500}}, CullIntersecting},
+	}
+
+	for _, c := range tests {
+		if r := f.TestAABB(c.Box); r != c.Expected {
+			t.Errorf("%v failed: TestAABB(%v) != %v (got %v)", c.Description, c.Box, c.Expected, r)
+		}
+	}
+}
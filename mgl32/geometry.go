@@ -0,0 +1,213 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// PointSegmentDistance returns the shortest distance between the point p and the line
+// segment from a to b. If a and b are the same point this is simply the distance
+// from p to a.
+func PointSegmentDistance(p, a, b Vec3) float32 {
+	ab := b.Sub(a)
+	l2 := ab.Dot(ab)
+	if l2 <= Epsilon {
+		return p.Sub(a).Len()
+	}
+
+	t := Clamp(p.Sub(a).Dot(ab)/l2, 0, 1)
+	return p.Sub(a.Add(ab.Mul(t))).Len()
+}
+
+// SegmentSegmentDistance returns the shortest distance between the line segment from
+// a1 to a2 and the line segment from b1 to b2. This is the basis of capsule-capsule
+// collision: two capsules intersect if the distance between their core segments is
+// no more than the sum of their radii.
+//
+// Parallel and degenerate (zero-length) segments are handled. The algorithm is the
+// one described in Ericson, "Real-Time Collision Detection", section 5.1.9.
+func SegmentSegmentDistance(a1, a2, b1, b2 Vec3) float32 {
+	d1, d2 := a2.Sub(a1), b2.Sub(b1)
+	r := a1.Sub(b1)
+	a, e := d1.Dot(d1), d2.Dot(d2)
+	f := d2.Dot(r)
+
+	var s, t float32
+	switch {
+	case a <= Epsilon && e <= Epsilon:
+		// Both segments are points
+		return r.Len()
+	case a <= Epsilon:
+		// The first segment is a point
+		t = Clamp(f/e, 0, 1)
+	default:
+		c := d1.Dot(r)
+		if e <= Epsilon {
+			// The second segment is a point
+			s = Clamp(-c/a, 0, 1)
+		} else {
+			b := d1.Dot(d2)
+			denom := a*e - b*b
+
+			// If the segments aren't parallel, find the closest point on the first
+			// segment's line to the second's line and clamp it. Otherwise any s will
+			// do, so pick the start.
+			if denom != 0 {
+				s = Clamp((b*f-c*e)/denom, 0, 1)
+			}
+
+			t = (b*s + f) / e
+			if t < 0 {
+				t = 0
+				s = Clamp(-c/a, 0, 1)
+			} else if t > 1 {
+				t = 1
+				s = Clamp((b-c)/a, 0, 1)
+			}
+		}
+	}
+
+	c1 := a1.Add(d1.Mul(s))
+	c2 := b1.Add(d2.Mul(t))
+	return c1.Sub(c2).Len()
+}
+
+// Centroid returns the mean of a set of points. The centroid of an empty set is
+// the origin.
+func Centroid(points []Vec3) Vec3 {
+	if len(points) == 0 {
+		return Vec3{}
+	}
+
+	var sum Vec3
+	for _, p := range points {
+		sum = sum.Add(p)
+	}
+
+	return sum.Mul(1 / float32(len(points)))
+}
+
+// Covariance returns the covariance matrix of a set of points about their centroid,
+// normalized by the number of points (that is, the population covariance). Element
+// (i, j) is the mean of (p[i]-c[i])*(p[j]-c[j]), so the matrix is symmetric and its
+// eigenvectors are the principal axes of the set, which is what PCA-based fitting
+// of bounding volumes uses.
+//
+// The covariance of an empty set is the zero matrix.
+func Covariance(points []Vec3) Mat3 {
+	if len(points) == 0 {
+		return Mat3{}
+	}
+
+	c := Centroid(points)
+	var cov Mat3
+	for _, p := range points {
+		d := p.Sub(c)
+		for j := 0; j < 3; j++ {
+			for i := 0; i < 3; i++ {
+				cov[j*3+i] += d[i] * d[j]
+			}
+		}
+	}
+
+	return cov.Mul(1 / float32(len(points)))
+}
+
+// TriangleCentroid returns the centroid of the triangle abc, the average of its vertices.
+func TriangleCentroid(a, b, c Vec3) Vec3 {
+	return a.Add(b).Add(c).Mul(1.0 / 3)
+}
+
+// TriangleIncenter returns the incenter of the triangle abc, the center of its inscribed
+// circle. It is the average of the vertices weighted by the length of the opposite edge.
+// For a degenerate triangle whose vertices all coincide, this returns that vertex.
+func TriangleIncenter(a, b, c Vec3) Vec3 {
+	la, lb, lc := b.Sub(c).Len(), c.Sub(a).Len(), a.Sub(b).Len()
+	sum := la + lb + lc
+	if sum == 0 {
+		return a
+	}
+
+	return a.Mul(la).Add(b.Mul(lb)).Add(c.Mul(lc)).Mul(1 / sum)
+}
+
+// DihedralAngle returns the angle between the planes of the triangles (sharedEdge0,
+// sharedEdge1, a) and (sharedEdge1, sharedEdge0, b), which share the edge from
+// sharedEdge0 to sharedEdge1. This is the angle between their face normals when both
+// are wound consistently, in the range [0, Pi]: it is 0 when the triangles are coplanar
+// and lie on opposite sides of the edge (the surface is flat), and Pi when they are
+// folded flat onto each other. The angle is computed with Atan2 so that it stays
+// accurate near both extremes.
+//
+// If either triangle is degenerate, the result is 0.
+func DihedralAngle(a, b, sharedEdge0, sharedEdge1 Vec3) float32 {
+	edge := sharedEdge1.Sub(sharedEdge0)
+	n1 := edge.Cross(a.Sub(sharedEdge0))
+	n2 := edge.Mul(-1).Cross(b.Sub(sharedEdge1))
+
+	return float32(math.Atan2(float64(n1.Cross(n2).Len()), float64(n1.Dot(n2))))
+}
+
+// BoundingSphere returns a sphere enclosing all of the given points, using Ritter's
+// approximation: an initial sphere is fitted to two points far apart from each other,
+// then grown just enough to take in each point left outside it. The result is usually
+// within a few percent of the smallest enclosing sphere, and is computed in linear time.
+//
+// Due to rounding, points on the surface of the sphere may lie outside it by a tiny
+// relative amount. An empty set has a zero center and radius, and a single point has
+// a radius of zero.
+func BoundingSphere(points []Vec3) (center Vec3, radius float32) {
+	if len(points) == 0 {
+		return Vec3{}, 0
+	}
+
+	farthest := func(from Vec3) Vec3 {
+		best, bestDist := from, float32(0)
+		for _, p := range points {
+			d := p.Sub(from)
+			if dist := d.Dot(d); dist > bestDist {
+				best, bestDist = p, dist
+			}
+		}
+		return best
+	}
+
+	a := farthest(points[0])
+	b := farthest(a)
+	center, radius = a.Add(b).Mul(0.5), b.Sub(a).Len()/2
+
+	for _, p := range points {
+		d := p.Sub(center).Len()
+		if d <= radius {
+			continue
+		}
+
+		// Grow the sphere so that its far side stays put and it reaches p
+		newRadius := (radius + d) / 2
+		center = center.Add(p.Sub(center).Mul((newRadius - radius) / d))
+		radius = newRadius
+	}
+
+	return center, radius
+}
+
+// Gradient estimates the gradient of the scalar field f at p using central differences
+// with step h along each axis, that is, element i is (f(p+h*e_i) - f(p-h*e_i)) / 2h.
+// The error is proportional to h squared for smooth fields, but h should not be so
+// small that the differences are lost to rounding.
+//
+// For an implicit surface such as a signed distance field or the density used in
+// marching cubes, the normalized gradient is the surface normal.
+func Gradient(f func(Vec3) float32, p Vec3, h float32) Vec3 {
+	var grad Vec3
+	for i := 0; i < 3; i++ {
+		var d Vec3
+		d[i] = h
+		grad[i] = (f(p.Add(d)) - f(p.Sub(d))) / (2 * h)
+	}
+
+	return grad
+}
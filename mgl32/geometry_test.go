@@ -0,0 +1,193 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestPointSegmentDistance(t *testing.T) {
+	tests := []struct {
+		Description string
+		P, A, B     Vec3
+		Expected    float32
+	}{
+		{"perpendicular to middle", Vec3{1, 2, 0}, Vec3{0, 0, 0}, Vec3{2, 0, 0}, 2},
+		{"beyond end", Vec3{5, 4, 0}, Vec3{0, 0, 0}, Vec3{2, 0, 0}, 5},
+		{"before start", Vec3{-1, 0, 0}, Vec3{0, 0, 0}, Vec3{2, 0, 0}, 1},
+		{"on segment", Vec3{1, 1, 1}, Vec3{0, 0, 0}, Vec3{2, 2, 2}, 0},
+		{"degenerate", Vec3{0, 3, 4}, Vec3{0, 0, 0}, Vec3{0, 0, 0}, 5},
+	}
+
+	for _, c := range tests {
+		if r := PointSegmentDistance(c.P, c.A, c.B); !FloatEqualThreshold(r, c.Expected, 1e-5) {
+			t.Errorf("%v failed: PointSegmentDistance(%v, %v, %v) != %v (got %v)", c.Description, c.P, c.A, c.B, c.Expected, r)
+		}
+	}
+}
+
+func TestSegmentSegmentDistance(t *testing.T) {
+	tests := []struct {
+		Description    string
+		A1, A2, B1, B2 Vec3
+		Expected       float32
+	}{
+		{"crossing", Vec3{-1, 0, 0}, Vec3{1, 0, 0}, Vec3{0, -1, 0}, Vec3{0, 1, 0}, 0},
+		{"skew crossing", Vec3{-1, 0, 0}, Vec3{1, 0, 0}, Vec3{0, -1, 2}, Vec3{0, 1, 2}, 2},
+		{"parallel overlapping", Vec3{0, 0, 0}, Vec3{2, 0, 0}, Vec3{1, 3, 0}, Vec3{3, 3, 0}, 3},
+		{"parallel disjoint", Vec3{0, 0, 0}, Vec3{1, 0, 0}, Vec3{4, 4, 0}, Vec3{5, 4, 0}, 5},
+		{"collinear disjoint", Vec3{0, 0, 0}, Vec3{1, 0, 0}, Vec3{3, 0, 0}, Vec3{5, 0, 0}, 2},
+		{"end to end", Vec3{0, 0, 0}, Vec3{1, 0, 0}, Vec3{2, 1, 0}, Vec3{2, 5, 0}, float32(math.Sqrt2)},
+		{"first degenerate", Vec3{0, 2, 0}, Vec3{0, 2, 0}, Vec3{-1, 0, 0}, Vec3{1, 0, 0}, 2},
+		{"second degenerate", Vec3{-1, 0, 0}, Vec3{1, 0, 0}, Vec3{3, 0, 0}, Vec3{3, 0, 0}, 2},
+		{"both degenerate", Vec3{0, 0, 0}, Vec3{0, 0, 0}, Vec3{0, 3, 4}, Vec3{0, 3, 4}, 5},
+	}
+
+	for _, c := range tests {
+		if r := SegmentSegmentDistance(c.A1, c.A2, c.B1, c.B2); !FloatEqualThreshold(r, c.Expected, 1e-5) {
+			t.Errorf("%v failed: SegmentSegmentDistance(%v, %v, %v, %v) != %v (got %v)", c.Description, c.A1, c.A2, c.B1, c.B2, c.Expected, r)
+		}
+		// Distance is symmetric
+		if r := SegmentSegmentDistance(c.B1, c.B2, c.A1, c.A2); !FloatEqualThreshold(r, c.Expected, 1e-5) {
+			t.Errorf("%v failed: SegmentSegmentDistance(%v, %v, %v, %v) != %v (got %v)", c.Description, c.B1, c.B2, c.A1, c.A2, c.Expected, r)
+		}
+	}
+}
+
+func TestCentroidCovariance(t *testing.T) {
+	// Points symmetric about (1, 2, 3), spread differently along each axis.
+	c := Vec3{1, 2, 3}
+	var points []Vec3
+	for _, d := range []Vec3{{2, 0, 0}, {0, 1, 0}, {0, 0, 3}} {
+		points = append(points, c.Add(d), c.Sub(d))
+	}
+
+	if r := Centroid(points); !r.EqualThreshold(c, 1e-5) {
+		t.Errorf("Centroid(%v) != %v (got %v)", points, c, r)
+	}
+
+	e := Diag3(Vec3{8.0 / 6, 2.0 / 6, 18.0 / 6})
+	if r := Covariance(points); !r.ApproxEqualThreshold(e, 1e-5) {
+		t.Errorf("Covariance(%v) != %v (got %v)", points, e, r)
+	}
+
+	// A set stretched along a diagonal is correlated between X and Y.
+	diag := []Vec3{{1, 1, 0}, {-1, -1, 0}}
+	if r, e := Covariance(diag), (Mat3{1, 1, 0, 1, 1, 0, 0, 0, 0}); !r.ApproxEqualThreshold(e, 1e-5) {
+		t.Errorf("Covariance(%v) != %v (got %v)", diag, e, r)
+	}
+
+	if r := Centroid(nil); r != (Vec3{}) {
+		t.Errorf("Centroid(nil) != %v (got %v)", Vec3{}, r)
+	}
+
+	if r := Covariance(nil); r != (Mat3{}) {
+		t.Errorf("Covariance(nil) != %v (got %v)", Mat3{}, r)
+	}
+}
+
+func TestTriangleCentroidIncenter(t *testing.T) {
+	// Equilateral triangle centered on (1, 2, 3): the centroid and incenter coincide
+	center := Vec3{1, 2, 3}
+	h := float32(math.Sqrt(3)) / 2
+	a, b, c := center.Add(Vec3{0, 1, 0}), center.Add(Vec3{-h, -0.5, 0}), center.Add(Vec3{h, -0.5, 0})
+
+	if r := TriangleCentroid(a, b, c); !r.ApproxEqualThreshold(center, 1e-5) {
+		t.Errorf("TriangleCentroid(%v, %v, %v) != %v (got %v)", a, b, c, center, r)
+	}
+	if r := TriangleIncenter(a, b, c); !r.ApproxEqualThreshold(center, 1e-5) {
+		t.Errorf("TriangleIncenter(%v, %v, %v) != %v (got %v)", a, b, c, center, r)
+	}
+
+	// Right triangle with legs 3 and 4: the inradius is 1, so the incenter is (1, 1, 0)
+	a, b, c = Vec3{0, 0, 0}, Vec3{4, 0, 0}, Vec3{0, 3, 0}
+	if r, e := TriangleIncenter(a, b, c), (Vec3{1, 1, 0}); !r.ApproxEqualThreshold(e, 1e-5) {
+		t.Errorf("TriangleIncenter(%v, %v, %v) != %v (got %v)", a, b, c, e, r)
+	}
+	if r, e := TriangleCentroid(a, b, c), (Vec3{4.0 / 3, 1, 0}); !r.ApproxEqualThreshold(e, 1e-5) {
+		t.Errorf("TriangleCentroid(%v, %v, %v) != %v (got %v)", a, b, c, e, r)
+	}
+}
+
+func TestDihedralAngle(t *testing.T) {
+	e0, e1 := Vec3{0, 0, 0}, Vec3{2, 0, 0}
+	tests := []struct {
+		Description string
+		A, B        Vec3
+		Expected    float32
+	}{
+		{"coplanar, flat", Vec3{1, 1, 0}, Vec3{0.5, -3, 0}, 0},
+		{"coplanar, folded", Vec3{1, 1, 0}, Vec3{0.5, 3, 0}, math.Pi},
+		{"perpendicular", Vec3{1, 1, 0}, Vec3{1, 0, 2}, math.Pi / 2},
+		{"perpendicular, other side", Vec3{1, 1, 0}, Vec3{1, 0, -2}, math.Pi / 2},
+		{"45 degrees", Vec3{0, 1, 0}, Vec3{0, -1, 1}, math.Pi / 4},
+		{"degenerate", Vec3{1, 0, 0}, Vec3{1, 0, 2}, 0},
+	}
+
+	for _, c := range tests {
+		if r := DihedralAngle(c.A, c.B, e0, e1); !FloatEqualThreshold(r, c.Expected, 1e-5) {
+			t.Errorf("%v failed: DihedralAngle(%v, %v, %v, %v) != %v (got %v)", c.Description, c.A, c.B, e0, e1, c.Expected, r)
+		}
+	}
+}
+
+func TestBoundingSphere(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20; i++ {
+		offset := RandomVec3(r, 100)
+		points := make([]Vec3, 1+r.Intn(200))
+		for j := range points {
+			points[j] = RandomVec3(r, float32(1+i)).Add(offset)
+		}
+
+		center, radius := BoundingSphere(points)
+		for _, p := range points {
+			if d := p.Sub(center).Len(); d > radius*(1+1e-5) {
+				t.Errorf("BoundingSphere(...) = %v, %v does not contain %v (distance %v)", center, radius, p, d)
+			}
+		}
+	}
+
+	// The sphere around a box's corners is not much larger than the optimal one
+	var corners []Vec3
+	for _, x := range []float32{-1, 1} {
+		for _, y := range []float32{-1, 1} {
+			for _, z := range []float32{-1, 1} {
+				corners = append(corners, Vec3{x, y, z}.Add(Vec3{5, 6, 7}))
+			}
+		}
+	}
+	if center, radius := BoundingSphere(corners); !center.EqualThreshold(Vec3{5, 6, 7}, 1e-5) || !FloatEqualThreshold(radius, float32(math.Sqrt(3)), 1e-5) {
+		t.Errorf("BoundingSphere(%v) != %v, %v (got %v, %v)", corners, Vec3{5, 6, 7}, math.Sqrt(3), center, radius)
+	}
+
+	if center, radius := BoundingSphere([]Vec3{{1, 2, 3}}); center != (Vec3{1, 2, 3}) || radius != 0 {
+		t.Errorf("BoundingSphere of a single point != %v, 0 (got %v, %v)", Vec3{1, 2, 3}, center, radius)
+	}
+
+	if center, radius := BoundingSphere(nil); center != (Vec3{}) || radius != 0 {
+		t.Errorf("BoundingSphere(nil) != %v, 0 (got %v, %v)", Vec3{}, center, radius)
+	}
+}
+
+func TestGradient(t *testing.T) {
+	lenSqr := func(p Vec3) float32 { return p.Dot(p) }
+	sphere := func(p Vec3) float32 { return p.Len() - 2 }
+
+	for _, p := range []Vec3{{0, 0, 0}, {1, 2, 3}, {-0.5, 4, -2}} {
+		if r, e := Gradient(lenSqr, p, 1e-2), p.Mul(2); !r.EqualThreshold(e, 1e-3) {
+			t.Errorf("Gradient(|p|², %v) != %v (got %v)", p, e, r)
+		}
+	}
+
+	// The gradient of a signed distance field is the surface normal
+	p := Vec3{0, 2, 0}
+	if r, e := Gradient(sphere, p, 1e-2), (Vec3{0, 1, 0}); !r.EqualThreshold(e, 1e-3) {
+		t.Errorf("Gradient(sphere SDF, %v) != %v (got %v)", p, e, r)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// WalkLine2D calls visit, in order, for each integer grid cell on the line from a to b,
+// using Bresenham's line algorithm. The cell containing a point is the one given by
+// flooring its coordinates, so cell (x, y) covers [x, x+1) x [y, y+1), and the line is
+// drawn between the cells containing a and b. Both end cells are visited. If visit
+// returns false the walk stops early, which makes this suitable for tile-based
+// line-of-sight checks.
+//
+// Exactly one cell is visited per step along the major axis, so diagonal moves
+// do not visit the cells beside the corner being crossed.
+func WalkLine2D(a, b Vec2, visit func(x, y int) bool) {
+	x0, y0 := int(math.Floor(float64(a[0]))), int(math.Floor(float64(a[1])))
+	x1, y1 := int(math.Floor(float64(b[0]))), int(math.Floor(float64(b[1])))
+
+	dx, sx := x1-x0, 1
+	if dx < 0 {
+		dx, sx = -dx, -1
+	}
+	dy, sy := y1-y0, 1
+	if dy < 0 {
+		dy, sy = -dy, -1
+	}
+
+	// Works for steep and shallow lines alike: err tracks the error of both axes
+	// and whichever has fallen behind is advanced.
+	err := dx - dy
+	for {
+		if !visit(x0, y0) || (x0 == x1 && y0 == y1) {
+			return
+		}
+
+		e2 := 2 * err
+		if e2 > -dy {
+			err -= dy
+			x0 += sx
+		}
+		if e2 < dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWalkLine2D(t *testing.T) {
+	tests := []struct {
+		A, B     Vec2
+		Expected [][2]int
+	}{
+		{Vec2{0, 0}, Vec2{0, 0}, [][2]int{{0, 0}}},
+		{Vec2{0.5, 2.5}, Vec2{3.9, 2.1}, [][2]int{{0, 2}, {1, 2}, {2, 2}, {3, 2}}},
+		{Vec2{3, 2}, Vec2{0, 2}, [][2]int{{3, 2}, {2, 2}, {1, 2}, {0, 2}}},
+		{Vec2{1, -1}, Vec2{1, 2}, [][2]int{{1, -1}, {1, 0}, {1, 1}, {1, 2}}},
+		{Vec2{1, 2}, Vec2{1, -1}, [][2]int{{1, 2}, {1, 1}, {1, 0}, {1, -1}}},
+		{Vec2{0, 0}, Vec2{3, 3}, [][2]int{{0, 0}, {1, 1}, {2, 2}, {3, 3}}},
+		{Vec2{-0.5, 0.5}, Vec2{-3.5, -2.5}, [][2]int{{-1, 0}, {-2, -1}, {-3, -2}, {-4, -3}}},
+		{Vec2{0, 0}, Vec2{4, 2}, [][2]int{{0, 0}, {1, 0}, {2, 1}, {3, 1}, {4, 2}}},
+		{Vec2{0, 0}, Vec2{2, -4}, [][2]int{{0, 0}, {0, -1}, {1, -2}, {1, -3}, {2, -4}}},
+	}
+
+	for _, c := range tests {
+		var cells [][2]int
+		WalkLine2D(c.A, c.B, func(x, y int) bool {
+			cells = append(cells, [2]int{x, y})
+			return true
+		})
+
+		if !reflect.DeepEqual(cells, c.Expected) {
+			t.Errorf("WalkLine2D(%v, %v) visited %v, expected %v", c.A, c.B, cells, c.Expected)
+		}
+	}
+}
+
+func TestWalkLine2DStop(t *testing.T) {
+	var cells [][2]int
+	WalkLine2D(Vec2{0, 0}, Vec2{10, 0}, func(x, y int) bool {
+		cells = append(cells, [2]int{x, y})
+		return x < 2
+	})
+
+	if e := [][2]int{{0, 0}, {1, 0}, {2, 0}}; !reflect.DeepEqual(cells, e) {
+		t.Errorf("WalkLine2D stopping at x=2 visited %v, expected %v", cells, e)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// Float32ToFloat16 converts f to an IEEE 754 half precision float, as used by
+// GL_HALF_FLOAT vertex attributes and textures, rounding to the nearest
+// representable value (ties to even).
+//
+// Values too large for half precision (above 65504 after rounding) become infinity
+// and values too small become (signed) zero, with subnormal halves used in between.
+// Infinities keep their sign and NaNs become a quiet NaN.
+func Float32ToFloat16(f float32) uint16 {
+	// Widening to float64 is exact, so work on the bits of the double
+	bits := math.Float64bits(float64(f))
+	sign := uint16(bits>>48) & 0x8000
+	exp := int(bits>>52) & 0x7ff
+	mant := bits & (1<<52 - 1)
+
+	if exp == 0x7ff {
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+
+	// Re-bias the exponent from double (1023) to half (15)
+	e := exp - 1023 + 15
+	if e >= 31 {
+		return sign | 0x7c00
+	}
+
+	// Subnormal halves have an implicit exponent of 1 and no implicit leading bit,
+	// so shift the significand right by the difference
+	shift := uint(52 - 10)
+	if e <= 0 {
+		if e < -10 {
+			return sign
+		}
+		mant |= 1 << 52
+		shift += uint(1 - e)
+		e = 0
+	}
+
+	half := mant >> shift
+	rem, halfway := mant&(1<<shift-1), uint64(1)<<(shift-1)
+	if rem > halfway || (rem == halfway && half&1 == 1) {
+		// Rounding up may carry into the exponent, which is still correct
+		half++
+	}
+
+	return sign | uint16(uint64(e)<<10+half)
+}
+
+// Float16ToFloat32 converts an IEEE 754 half precision float to a float32. Every half
+// precision value, including subnormals, infinities and NaN, is exactly representable.
+func Float16ToFloat32(h uint16) float32 {
+	sign := float64(1)
+	if h&0x8000 != 0 {
+		sign = -1
+	}
+	exp := int(h>>10) & 0x1f
+	mant := float64(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		return float32(sign * math.Ldexp(mant, -24))
+	case 0x1f:
+		if mant != 0 {
+			return float32(math.NaN())
+		}
+		return float32(math.Inf(int(sign)))
+	default:
+		return float32(sign * math.Ldexp(1024+mant, exp-25))
+	}
+}
+
+// PackHalf converts each element of the vector to half precision with Float32ToFloat16.
+func (v Vec3) PackHalf() [3]uint16 {
+	return [3]uint16{Float32ToFloat16(v[0]), Float32ToFloat16(v[1]), Float32ToFloat16(v[2])}
+}
+
+// PackHalf converts each element of the vector to half precision with Float32ToFloat16.
+func (v Vec4) PackHalf() [4]uint16 {
+	return [4]uint16{Float32ToFloat16(v[0]), Float32ToFloat16(v[1]), Float32ToFloat16(v[2]), Float32ToFloat16(v[3])}
+}
+
+// UnpackHalf3 is the inverse of Vec3.PackHalf, converting each half precision element
+// back with Float16ToFloat32.
+func UnpackHalf3(h [3]uint16) Vec3 {
+	return Vec3{Float16ToFloat32(h[0]), Float16ToFloat32(h[1]), Float16ToFloat32(h[2])}
+}
+
+// UnpackHalf4 is the inverse of Vec4.PackHalf, converting each half precision element
+// back with Float16ToFloat32.
+func UnpackHalf4(h [4]uint16) Vec4 {
+	return Vec4{Float16ToFloat32(h[0]), Float16ToFloat32(h[1]), Float16ToFloat32(h[2]), Float16ToFloat32(h[3])}
+}
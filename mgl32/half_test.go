@@ -0,0 +1,82 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat32ToFloat16(t *testing.T) {
+	tests := []struct {
+		Description string
+		F           float32
+		H           uint16
+	}{
+		{"zero", 0, 0x0000},
+		{"negative zero", float32(math.Copysign(0, -1)), 0x8000},
+		{"one", 1, 0x3c00},
+		{"minus two", -2, 0xc000},
+		{"one third", 1.0 / 3, 0x3555},
+		{"max", 65504, 0x7bff},
+		{"rounds to max", 65519, 0x7bff},
+		{"overflow", 65520, 0x7c00},
+		{"smallest normal", float32(math.Ldexp(1, -14)), 0x0400},
+		{"largest subnormal", float32(math.Ldexp(1023, -24)), 0x03ff},
+		{"smallest subnormal", float32(math.Ldexp(1, -24)), 0x0001},
+		{"half smallest subnormal ties to even", float32(math.Ldexp(1, -25)), 0x0000},
+		{"underflow", 1e-10, 0x0000},
+		{"negative underflow", -1e-10, 0x8000},
+		{"tie to even down", 1 + float32(math.Ldexp(1, -11)), 0x3c00},
+		{"tie to even up", 1 + float32(math.Ldexp(3, -11)), 0x3c02},
+		{"infinity", InfPos, 0x7c00},
+		{"negative infinity", InfNeg, 0xfc00},
+	}
+
+	for _, c := range tests {
+		if r := Float32ToFloat16(c.F); r != c.H {
+			t.Errorf("%v failed: Float32ToFloat16(%v) != %#04x (got %#04x)", c.Description, c.F, c.H, r)
+		}
+	}
+
+	if r := Float32ToFloat16(NaN); r&0x7c00 != 0x7c00 || r&0x3ff == 0 {
+		t.Errorf("Float32ToFloat16(NaN) is not a NaN (got %#04x)", r)
+	}
+}
+
+func TestFloat16RoundTrip(t *testing.T) {
+	// Every finite half converts to a float and back exactly
+	for h := 0; h <= 0xffff; h++ {
+		if h&0x7c00 == 0x7c00 {
+			continue
+		}
+		f := Float16ToFloat32(uint16(h))
+		if r := Float32ToFloat16(f); r != uint16(h) {
+			t.Errorf("Float32ToFloat16(Float16ToFloat32(%#04x)) = %#04x (via %v)", h, r, f)
+		}
+	}
+
+	if f := Float16ToFloat32(0x7c00); !math.IsInf(float64(f), 1) {
+		t.Errorf("Float16ToFloat32(0x7c00) != +Inf (got %v)", f)
+	}
+	if f := Float16ToFloat32(0xfc00); !math.IsInf(float64(f), -1) {
+		t.Errorf("Float16ToFloat32(0xfc00) != -Inf (got %v)", f)
+	}
+	if f := Float16ToFloat32(0x7e00); !math.IsNaN(float64(f)) {
+		t.Errorf("Float16ToFloat32(0x7e00) != NaN (got %v)", f)
+	}
+}
+
+func TestVecPackHalf(t *testing.T) {
+	v3 := Vec3{1.5, -0.25, 1000}
+	if r := UnpackHalf3(v3.PackHalf()); r != v3 {
+		t.Errorf("UnpackHalf3(%v.PackHalf()) != itself (got %v)", v3, r)
+	}
+
+	v4 := Vec4{0.1, 0.2, -3, 7}
+	if r := UnpackHalf4(v4.PackHalf()); !r.ApproxEqualThreshold(v4, 1e-3) {
+		t.Errorf("UnpackHalf4(%v.PackHalf()) != %v within half precision (got %v)", v4, v4, r)
+	}
+}
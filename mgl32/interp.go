@@ -0,0 +1,39 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Bilinear interpolates between four values at the corners of a unit square, as when
+// filtering a texture. cXY is the value at corner (X, Y), so c00 is returned at
+// tx=ty=0, c10 at tx=1, ty=0, and so on. tx and ty are normally in [0,1]; values
+// outside that range extrapolate.
+func Bilinear(c00, c10, c01, c11 Vec3, tx, ty float32) Vec3 {
+	bottom := c00.Add(c10.Sub(c00).Mul(tx))
+	top := c01.Add(c11.Sub(c01).Mul(tx))
+	return bottom.Add(top.Sub(bottom).Mul(ty))
+}
+
+// Trilinear interpolates between eight values at the corners of a unit cube, as when
+// sampling a volume texture. cXYZ is the value at corner (X, Y, Z), as for Bilinear.
+// It is equivalent to interpolating along Z between the bilinear interpolations of
+// the z=0 and z=1 faces.
+func Trilinear(c000, c100, c010, c110, c001, c101, c011, c111 Vec3, tx, ty, tz float32) Vec3 {
+	front := Bilinear(c000, c100, c010, c110, tx, ty)
+	back := Bilinear(c001, c101, c011, c111, tx, ty)
+	return front.Add(back.Sub(front).Mul(tz))
+}
+
+// BilinearScalar is the scalar version of Bilinear.
+func BilinearScalar(c00, c10, c01, c11 float32, tx, ty float32) float32 {
+	bottom := c00 + (c10-c00)*tx
+	top := c01 + (c11-c01)*tx
+	return bottom + (top-bottom)*ty
+}
+
+// TrilinearScalar is the scalar version of Trilinear.
+func TrilinearScalar(c000, c100, c010, c110, c001, c101, c011, c111 float32, tx, ty, tz float32) float32 {
+	front := BilinearScalar(c000, c100, c010, c110, tx, ty)
+	back := BilinearScalar(c001, c101, c011, c111, tx, ty)
+	return front + (back-front)*tz
+}
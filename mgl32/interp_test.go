@@ -0,0 +1,65 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestBilinear(t *testing.T) {
+	c00, c10, c01, c11 := Vec3{1, 0, 0}, Vec3{0, 1, 0}, Vec3{0, 0, 1}, Vec3{1, 1, 1}
+
+	tests := []struct {
+		Tx, Ty   float32
+		Expected Vec3
+	}{
+		{0, 0, c00},
+		{1, 0, c10},
+		{0, 1, c01},
+		{1, 1, c11},
+		{0.5, 0.5, Vec3{0.5, 0.5, 0.5}},
+		{0.5, 0, Vec3{0.5, 0.5, 0}},
+		{0.25, 1, Vec3{0.25, 0.25, 1}},
+	}
+
+	for _, c := range tests {
+		if r := Bilinear(c00, c10, c01, c11, c.Tx, c.Ty); !r.EqualThreshold(c.Expected, 1e-6) {
+			t.Errorf("Bilinear(%v, %v, %v, %v, %v, %v) != %v (got %v)", c00, c10, c01, c11, c.Tx, c.Ty, c.Expected, r)
+		}
+	}
+
+	scalar := []float32{1, 2, 3, 5}
+	for _, c := range []struct{ Tx, Ty, Expected float32 }{{0, 0, 1}, {1, 0, 2}, {0, 1, 3}, {1, 1, 5}, {0.5, 0.5, 2.75}} {
+		if r := BilinearScalar(scalar[0], scalar[1], scalar[2], scalar[3], c.Tx, c.Ty); !FloatEqualThreshold(r, c.Expected, 1e-6) {
+			t.Errorf("BilinearScalar(%v, %v, %v) != %v (got %v)", scalar, c.Tx, c.Ty, c.Expected, r)
+		}
+	}
+}
+
+func TestTrilinear(t *testing.T) {
+	// The value at each corner encodes its coordinates, so the interpolation at
+	// (tx, ty, tz) is the point itself.
+	corner := func(x, y, z float32) Vec3 { return Vec3{x, y, z} }
+	c := [8]Vec3{
+		corner(0, 0, 0), corner(1, 0, 0), corner(0, 1, 0), corner(1, 1, 0),
+		corner(0, 0, 1), corner(1, 0, 1), corner(0, 1, 1), corner(1, 1, 1),
+	}
+
+	for _, p := range append(c[:], Vec3{0.5, 0.5, 0.5}, Vec3{0.25, 0.75, 0.1}) {
+		if r := Trilinear(c[0], c[1], c[2], c[3], c[4], c[5], c[6], c[7], p[0], p[1], p[2]); !r.EqualThreshold(p, 1e-6) {
+			t.Errorf("Trilinear at %v != %v (got %v)", p, p, r)
+		}
+	}
+
+	// Scalar: the center is the average of the corners
+	s := [8]float32{0, 1, 2, 3, 4, 5, 6, 9}
+	if r := TrilinearScalar(s[0], s[1], s[2], s[3], s[4], s[5], s[6], s[7], 0.5, 0.5, 0.5); !FloatEqualThreshold(r, 3.75, 1e-6) {
+		t.Errorf("TrilinearScalar(%v) at the center != 3.75 (got %v)", s, r)
+	}
+
+	if r := TrilinearScalar(s[0], s[1], s[2], s[3], s[4], s[5], s[6], s[7], 1, 1, 1); r != 9 {
+		t.Errorf("TrilinearScalar(%v) at (1, 1, 1) != 9 (got %v)", s, r)
+	}
+}
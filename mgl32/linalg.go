@@ -0,0 +1,362 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// PolarDecompose splits m into an orthogonal rotation and a symmetric stretch such that
+// m = rotation * stretch. The rotation is the orthogonal matrix closest to m, which is
+// useful to recover a valid rotation from a matrix that has picked up skew or
+// numerical drift.
+//
+// The rotation is found by repeatedly averaging it with its inverse transpose,
+// starting from m, until no element changes by more than 1e-6 or 20 iterations
+// have been performed. The iteration converges quadratically, so for reasonably
+// conditioned matrices the cap is never reached.
+//
+// If m has a negative determinant the "rotation" contains a reflection. If m is
+// singular the decomposition doesn't exist and two zero matrices are returned.
+func (m Mat3) PolarDecompose() (rotation, stretch Mat3) {
+	const (
+		maxIterations = 20
+		tolerance     = 1e-6
+	)
+
+	q := m
+	for i := 0; i < maxIterations; i++ {
+		inv := q.Inv()
+		if inv == (Mat3{}) {
+			return Mat3{}, Mat3{}
+		}
+
+		next := q.Add(inv.Transpose()).Mul(0.5)
+		delta := float32(0)
+		for j := range next {
+			if d := Abs(next[j] - q[j]); d > delta {
+				delta = d
+			}
+		}
+
+		q = next
+		if delta <= tolerance {
+			break
+		}
+	}
+
+	return q, q.Transpose().Mul3(m)
+}
+
+// ConditionNumber estimates the 2-norm condition number of m, the ratio of its largest
+// to its smallest singular value. The singular values are the square roots of the
+// eigenvalues of the symmetric matrix mᵀm.
+//
+// A condition number near 1 means m is well behaved; as it grows, inverting m or solving
+// systems with it loses roughly log10(ConditionNumber) digits of precision. A singular
+// matrix returns +Inf.
+func (m Mat3) ConditionNumber() float32 {
+	values, _ := symmetricEigen3(m.Transpose().Mul3(m))
+	if values[2] <= 0 {
+		return InfPos
+	}
+
+	return float32(math.Sqrt(float64(values[0] / values[2])))
+}
+
+// IsSingular reports whether the absolute value of m's determinant is no more than eps,
+// which indicates that m has no (usable) inverse.
+func (m Mat3) IsSingular(eps float32) bool {
+	return Abs(m.Det()) <= eps
+}
+
+// singularTolerance is the relative size below which Solve and LU treat a determinant
+// or pivot as zero. The rounding error accumulated while computing either is a small
+// multiple of the machine epsilon, so anything within this margin may really be zero.
+var singularTolerance = 16 * machineEpsilon
+
+// Solve returns the x for which m.Mul3x1(x) equals b, using Cramer's rule. This is
+// more direct (and a little more accurate) than computing m.Inv().Mul3x1(b).
+//
+// If m is singular there is no unique solution and ok is false. m is considered
+// singular if its determinant is within rounding error of zero, relative to the largest
+// determinant possible for columns of the same lengths, so the test doesn't depend on
+// the overall scale of m. Use IsSingular for a test with a chosen threshold.
+func (m Mat3) Solve(b Vec3) (x Vec3, ok bool) {
+	det := m.Det()
+	bound := m.Col(0).Len() * m.Col(1).Len() * m.Col(2).Len()
+	if Abs(det) <= singularTolerance*bound {
+		return Vec3{}, false
+	}
+
+	for i := 0; i < 3; i++ {
+		mi := m
+		mi.SetCol(i, b)
+		x[i] = mi.Det() / det
+	}
+
+	return x, true
+}
+
+// LU computes the LU decomposition of m with partial pivoting, such that L*U equals m
+// with its rows permuted: row i of L*U is row perm[i] of m. L is unit lower triangular
+// and U is upper triangular. Use SolveLU to solve linear systems with the result;
+// factoring once and solving repeatedly is cheaper than inverting m, and pivoting makes
+// it more stable than the cofactor-based Inv for ill-conditioned matrices.
+//
+// If m is singular (some pivot is within rounding error of zero, relative to the largest
+// element of m) ok is false and the other results are undefined.
+func (m Mat4) LU() (l, u Mat4, perm [4]int, ok bool) {
+	var a [4][4]float32
+	var maxAbs float32
+	for r := 0; r < 4; r++ {
+		perm[r] = r
+		for c := 0; c < 4; c++ {
+			a[r][c] = m.At(r, c)
+			if v := Abs(a[r][c]); v > maxAbs {
+				maxAbs = v
+			}
+		}
+	}
+
+	for k := 0; k < 4; k++ {
+		// Swap the row with the largest element in column k into place
+		p := k
+		for r := k + 1; r < 4; r++ {
+			if Abs(a[r][k]) > Abs(a[p][k]) {
+				p = r
+			}
+		}
+		if Abs(a[p][k]) <= singularTolerance*maxAbs {
+			return Mat4{}, Mat4{}, perm, false
+		}
+		a[k], a[p] = a[p], a[k]
+		perm[k], perm[p] = perm[p], perm[k]
+
+		// Eliminate below the pivot, storing the multipliers in the lower triangle
+		for r := k + 1; r < 4; r++ {
+			a[r][k] /= a[k][k]
+			for c := k + 1; c < 4; c++ {
+				a[r][c] -= a[r][k] * a[k][c]
+			}
+		}
+	}
+
+	for r := 0; r < 4; r++ {
+		for c := 0; c < 4; c++ {
+			switch {
+			case r > c:
+				l.Set(r, c, a[r][c])
+			case r == c:
+				l.Set(r, c, 1)
+				u.Set(r, c, a[r][c])
+			default:
+				u.Set(r, c, a[r][c])
+			}
+		}
+	}
+
+	return l, u, perm, true
+}
+
+// SolveLU returns the x for which m.Mul4x1(x) equals b, given the decomposition of m
+// computed by m.LU(), by forward and back substitution.
+func SolveLU(l, u Mat4, perm [4]int, b Vec4) Vec4 {
+	// Solve L*y = P*b
+	var y Vec4
+	for r := 0; r < 4; r++ {
+		y[r] = b[perm[r]]
+		for c := 0; c < r; c++ {
+			y[r] -= l.At(r, c) * y[c]
+		}
+	}
+
+	// Solve U*x = y
+	var x Vec4
+	for r := 3; r >= 0; r-- {
+		x[r] = y[r]
+		for c := r + 1; c < 4; c++ {
+			x[r] -= u.At(r, c) * x[c]
+		}
+		x[r] /= u.At(r, r)
+	}
+
+	return x
+}
+
+// QR computes the QR decomposition of m using Householder reflections: q is orthogonal
+// and r is upper triangular, with q.Mul3(r) equal to m. The diagonal of r may contain
+// negative values. For a non-singular m the columns of q are an orthonormal basis
+// obtained as if by Gram-Schmidt on the columns of m, but computed far more stably.
+func (m Mat3) QR() (q, r Mat3) {
+	q, r = Ident3(), m
+	householderQR(3, q[:], r[:])
+	return q, r
+}
+
+// QR computes the QR decomposition of m using Householder reflections: q is orthogonal
+// and r is upper triangular, with q.Mul4(r) equal to m. The diagonal of r may contain
+// negative values.
+func (m Mat4) QR() (q, r Mat4) {
+	q, r = Ident4(), m
+	householderQR(4, q[:], r[:])
+	return q, r
+}
+
+// householderQR factors the n by n column-major matrix in r, which it overwrites with
+// the upper triangular factor, while q (which should start as the identity) is
+// multiplied on the right by each reflection to become the orthogonal factor.
+// n must be at most 4.
+func householderQR(n int, q, r []float32) {
+	var buf [4]float32
+	v := buf[:n]
+	for k := 0; k < n-1; k++ {
+		// Reflect column k onto the diagonal, choosing the sign that avoids cancellation
+		var norm float32
+		for i := k; i < n; i++ {
+			norm += r[k*n+i] * r[k*n+i]
+		}
+		norm = float32(math.Sqrt(float64(norm)))
+		if norm == 0 {
+			continue
+		}
+		alpha := -norm
+		if r[k*n+k] < 0 {
+			alpha = norm
+		}
+
+		var vv float32
+		for i := k; i < n; i++ {
+			v[i] = r[k*n+i]
+			if i == k {
+				v[i] -= alpha
+			}
+			vv += v[i] * v[i]
+		}
+		if vv == 0 {
+			continue
+		}
+
+		// r = H*r
+		for c := k; c < n; c++ {
+			var d float32
+			for i := k; i < n; i++ {
+				d += v[i] * r[c*n+i]
+			}
+			d *= 2 / vv
+			for i := k; i < n; i++ {
+				r[c*n+i] -= d * v[i]
+			}
+		}
+
+		// q = q*H
+		for row := 0; row < n; row++ {
+			var d float32
+			for i := k; i < n; i++ {
+				d += q[i*n+row] * v[i]
+			}
+			d *= 2 / vv
+			for i := k; i < n; i++ {
+				q[i*n+row] -= d * v[i]
+			}
+		}
+
+		for i := k + 1; i < n; i++ {
+			r[k*n+i] = 0
+		}
+	}
+}
+
+// Sqrt returns the principal square root of m, the unique symmetric positive-definite
+// matrix s such that s.Mul3(s) equals m. It is computed from the eigendecomposition
+// m = V*D*Vᵀ as V*sqrt(D)*Vᵀ.
+//
+// The square root only exists in this form if m is symmetric positive-definite. If it
+// isn't (within a small tolerance for symmetry), ok is false and the returned
+// matrix is the zero matrix.
+func (m Mat3) Sqrt() (sqrt Mat3, ok bool) {
+	for i := 0; i < 3; i++ {
+		for j := i + 1; j < 3; j++ {
+			if !FloatEqualThreshold(m.At(i, j), m.At(j, i), 1e-5) {
+				return Mat3{}, false
+			}
+		}
+	}
+
+	values, vectors := symmetricEigen3(m)
+	if values[2] <= 0 {
+		return Mat3{}, false
+	}
+
+	for i := range values {
+		values[i] = float32(math.Sqrt(float64(values[i])))
+	}
+
+	return vectors.Mul3(Diag3(values)).Mul3(vectors.Transpose()), true
+}
+
+// symmetricEigen3 computes the eigenvalues and eigenvectors of the symmetric matrix m
+// using cyclic Jacobi rotations. The eigenvalues are returned in descending order, and
+// the i-th column of vectors is the unit eigenvector corresponding to values[i].
+//
+// Only the upper triangle of m is effectively used; if m isn't symmetric the
+// result is meaningless.
+func symmetricEigen3(m Mat3) (values Vec3, vectors Mat3) {
+	const maxSweeps = 32
+
+	a, v := m, Ident3()
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		off := a[3]*a[3] + a[6]*a[6] + a[7]*a[7]
+		diag := a[0]*a[0] + a[4]*a[4] + a[8]*a[8]
+		if off <= 1e-14*diag || off == 0 {
+			break
+		}
+
+		for p := 0; p < 2; p++ {
+			for q := p + 1; q < 3; q++ {
+				apq := a.At(p, q)
+				if apq == 0 {
+					continue
+				}
+
+				// Choose the rotation that zeroes a[p][q]
+				theta := (a.At(q, q) - a.At(p, p)) / (2 * apq)
+				t := 1 / (Abs(theta) + float32(math.Sqrt(float64(theta*theta+1))))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / float32(math.Sqrt(float64(t*t+1)))
+				s := t * c
+
+				rot := Ident3()
+				rot.Set(p, p, c)
+				rot.Set(q, q, c)
+				rot.Set(p, q, s)
+				rot.Set(q, p, -s)
+
+				a = rot.Transpose().Mul3(a).Mul3(rot)
+				a.Set(p, q, 0)
+				a.Set(q, p, 0)
+				v = v.Mul3(rot)
+			}
+		}
+	}
+
+	values = a.Diag()
+
+	// Sort descending, keeping the eigenvectors with their eigenvalues
+	for i := 0; i < 2; i++ {
+		for j := i + 1; j < 3; j++ {
+			if values[j] > values[i] {
+				values[i], values[j] = values[j], values[i]
+				ci, cj := v.Col(i), v.Col(j)
+				v.SetCol(i, cj)
+				v.SetCol(j, ci)
+			}
+		}
+	}
+
+	return values, v
+}
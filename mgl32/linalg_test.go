@@ -0,0 +1,282 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+// absEqual compares floats with an absolute tolerance, which is more useful
+// than FloatEqualThreshold when the expected values are zero.
+func absEqual(epsilon float32) func(float32, float32) bool {
+	return func(a, b float32) bool {
+		return Abs(a-b) <= epsilon
+	}
+}
+
+func TestPolarDecompose(t *testing.T) {
+	rot := HomogRotate3D(math.Pi/5, Vec3{1, 2, 3}.Normalize()).Mat3()
+	scale := Diag3(Vec3{2, 3, 4})
+	shear := Mat3{2, 0.5, 0, 0.5, 3, 0.25, 0, 0.25, 1}
+
+	tests := []struct {
+		Description string
+		M           Mat3
+		Rotation    Mat3
+		Stretch     Mat3
+	}{
+		{"identity", Ident3(), Ident3(), Ident3()},
+		{"pure rotation", rot, rot, Ident3()},
+		{"scaled rotation", rot.Mul3(scale), rot, scale},
+		{"symmetric stretch", rot.Mul3(shear), rot, shear},
+	}
+
+	eq := absEqual(1e-4)
+	for _, c := range tests {
+		r, s := c.M.PolarDecompose()
+		if !r.ApproxFuncEqual(c.Rotation, eq) {
+			t.Errorf("%v failed: PolarDecompose(%v) rotation != %v (got %v)", c.Description, c.M, c.Rotation, r)
+		}
+		if !s.ApproxFuncEqual(c.Stretch, eq) {
+			t.Errorf("%v failed: PolarDecompose(%v) stretch != %v (got %v)", c.Description, c.M, c.Stretch, s)
+		}
+		if !r.Mul3(s).ApproxFuncEqual(c.M, eq) {
+			t.Errorf("%v failed: rotation * stretch != %v (got %v)", c.Description, c.M, r.Mul3(s))
+		}
+	}
+
+	if r, s := (Mat3{}).PolarDecompose(); r != (Mat3{}) || s != (Mat3{}) {
+		t.Errorf("PolarDecompose of singular matrix did not return zero matrices (got %v, %v)", r, s)
+	}
+}
+
+func TestSymmetricEigen3(t *testing.T) {
+	rot := HomogRotate3D(0.7, Vec3{1, -1, 2}.Normalize()).Mat3()
+	m := rot.Mul3(Diag3(Vec3{1, 5, 3})).Mul3(rot.Transpose())
+
+	values, vectors := symmetricEigen3(m)
+	if !values.ApproxEqualThreshold(Vec3{5, 3, 1}, 1e-4) {
+		t.Errorf("symmetricEigen3(%v) eigenvalues != %v (got %v)", m, Vec3{5, 3, 1}, values)
+	}
+
+	for i := 0; i < 3; i++ {
+		v := vectors.Col(i)
+		if !m.Mul3x1(v).ApproxFuncEqual(v.Mul(values[i]), absEqual(1e-4)) {
+			t.Errorf("symmetricEigen3(%v) eigenvector %v is not an eigenvector (M*v = %v, lambda*v = %v)", m, v, m.Mul3x1(v), v.Mul(values[i]))
+		}
+	}
+}
+
+func TestConditionNumber(t *testing.T) {
+	rot := HomogRotate3D(math.Pi/3, Vec3{0, 1, 1}.Normalize()).Mat3()
+
+	tests := []struct {
+		Description string
+		M           Mat3
+		Expected    float32
+	}{
+		{"identity", Ident3(), 1},
+		{"rotation", rot, 1},
+		{"scale", Diag3(Vec3{1, 2, 8}), 8},
+		{"scaled rotation", rot.Mul3(Diag3(Vec3{0.5, 2, 1})), 4},
+		{"nearly singular", Diag3(Vec3{1, 1, 1e-3}), 1e3},
+	}
+
+	for _, c := range tests {
+		if r := c.M.ConditionNumber(); !FloatEqualThreshold(r, c.Expected, 1e-3) {
+			t.Errorf("%v failed: ConditionNumber(%v) != %v (got %v)", c.Description, c.M, c.Expected, r)
+		}
+	}
+
+	if r := (Mat3{1, 2, 3, 2, 4, 6, 0, 0, 1}).ConditionNumber(); !math.IsInf(float64(r), 1) && r < 1e6 {
+		t.Errorf("ConditionNumber of singular matrix is not very large (got %v)", r)
+	}
+}
+
+func TestIsSingular(t *testing.T) {
+	if (Diag3(Vec3{1, 2, 3})).IsSingular(1e-6) {
+		t.Errorf("IsSingular returned true for a well-conditioned matrix")
+	}
+
+	if !(Mat3{1, 2, 3, 2, 4, 6, 0, 0, 1}).IsSingular(1e-6) {
+		t.Errorf("IsSingular returned false for a singular matrix")
+	}
+
+	if !(Diag3(Vec3{1, 1, 1e-8})).IsSingular(1e-6) {
+		t.Errorf("IsSingular returned false for a nearly-singular matrix")
+	}
+}
+
+func TestMat3Solve(t *testing.T) {
+	m := Mat3{2, 1, 0, -1, 3, 2, 4, 0, 1}
+	for _, x := range []Vec3{{1, 2, 3}, {0, 0, 0}, {-0.5, 7, 2}} {
+		b := m.Mul3x1(x)
+		if r, ok := m.Solve(b); !ok || !r.EqualThreshold(x, 1e-5) {
+			t.Errorf("%v.Solve(%v) != %v, true (got %v, %v)", m, b, x, r, ok)
+		}
+	}
+
+	// Scaling the whole system doesn't change the answer
+	b := Vec3{1, 2, 3}
+	small := m.Mul(1e-6)
+	if r, ok := small.Solve(b.Mul(1e-6)); !ok || !r.EqualThreshold(m.Inv().Mul3x1(b), 1e-4) {
+		t.Errorf("%v.Solve(%v) != %v, true (got %v, %v)", small, b.Mul(1e-6), m.Inv().Mul3x1(b), r, ok)
+	}
+
+	// The third column is a combination of the others, but 0.1 and 0.3 aren't exactly
+	// representable, so the determinant is rounding noise rather than zero
+	c0, c1 := Vec3{0.7, 1.3, -2.1}, Vec3{1.9, -0.4, 2.6}
+	inexact := Mat3FromCols(c0, c1, c0.Mul(0.1).Add(c1.Mul(0.3)))
+
+	for _, singular := range []Mat3{{}, {1, 2, 3, 2, 4, 6, 0, 1, 1}, inexact} {
+		if r, ok := singular.Solve(b); ok {
+			t.Errorf("%v.Solve(%v) != _, false (got %v, %v)", singular, b, r, ok)
+		}
+	}
+}
+
+func TestMat4LU(t *testing.T) {
+	tests := []Mat4{
+		Ident4(),
+		{0, 1, 0, 0, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 1, 0},
+		{2, -1, 4, 3, 1, 5, 0, -2, 7, 1, 1, 1, -3, 2, 6, 8},
+		Translate3D(1, 2, 3).Mul4(HomogRotate3D(0.7, Vec3{1, 1, 1}.Normalize())).Mul4(Scale3D(2, 0.5, 3)),
+	}
+
+	eq := absEqual(1e-4)
+	for _, m := range tests {
+		l, u, perm, ok := m.LU()
+		if !ok {
+			t.Errorf("%v.LU() was not ok", m)
+			continue
+		}
+
+		var pm Mat4
+		for r := 0; r < 4; r++ {
+			pm.SetRow(r, m.Row(perm[r]))
+		}
+		if r := l.Mul4(u); !r.ApproxFuncEqual(pm, eq) {
+			t.Errorf("%v.LU() gives L*U = %v, expected the permuted matrix %v", m, r, pm)
+		}
+
+		for r := 0; r < 4; r++ {
+			if l.At(r, r) != 1 {
+				t.Errorf("%v.LU() gives L without a unit diagonal (got %v)", m, l)
+			}
+			for c := r + 1; c < 4; c++ {
+				if l.At(r, c) != 0 || u.At(c, r) != 0 {
+					t.Errorf("%v.LU() gives non-triangular factors (got %v, %v)", m, l, u)
+				}
+			}
+		}
+
+		x := Vec4{1, -2, 3, 0.5}
+		if r := SolveLU(l, u, perm, m.Mul4x1(x)); !r.ApproxFuncEqual(x, eq) {
+			t.Errorf("SolveLU for %v != %v (got %v)", m, x, r)
+		}
+	}
+
+	// As in TestMat3Solve, a column that is an inexact combination of the others
+	c0, c1, c3 := Vec4{0.7, 1.3, -2.1, 1}, Vec4{1.9, -0.4, 2.6, -2}, Vec4{1, 0, 4, 3}
+	inexact := Mat4FromCols(c0, c1, c0.Mul(0.1).Add(c1.Mul(0.3)), c3)
+
+	for _, singular := range []Mat4{{}, Scale3D(1, 0, 1), {1, 2, 3, 4, 2, 4, 6, 8, 0, 1, 0, 1, 1, 0, 1, 0}, inexact} {
+		if _, _, _, ok := singular.LU(); ok {
+			t.Errorf("%v.LU() was ok for a singular matrix", singular)
+		}
+	}
+}
+
+func TestMat3QR(t *testing.T) {
+	tests := []Mat3{
+		Ident3(),
+		{0, 1, 0, 1, 0, 0, 0, 0, 1},
+		{2, -1, 4, 1, 5, 0, 7, 1, 1},
+		{1, 2, 3, 2, 4, 6, 0, 1, 1},
+		HomogRotate3D(0.9, Vec3{1, -2, 1}.Normalize()).Mat3().Mul3(Diag3(Vec3{3, 0.5, 2})),
+	}
+
+	eq := absEqual(1e-4)
+	for _, m := range tests {
+		q, r := m.QR()
+		if p := q.Transpose().Mul3(q); !p.ApproxFuncEqual(Ident3(), eq) {
+			t.Errorf("%v.QR() gives a non-orthonormal Q: Qᵀ*Q = %v", m, p)
+		}
+		if p := q.Mul3(r); !p.ApproxFuncEqual(m, eq) {
+			t.Errorf("%v.QR() gives Q*R = %v", m, p)
+		}
+		if r[1] != 0 || r[2] != 0 || r[5] != 0 {
+			t.Errorf("%v.QR() gives a non-upper-triangular R (got %v)", m, r)
+		}
+	}
+}
+
+func TestMat4QR(t *testing.T) {
+	tests := []Mat4{
+		Ident4(),
+		{2, -1, 4, 3, 1, 5, 0, -2, 7, 1, 1, 1, -3, 2, 6, 8},
+		Translate3D(1, 2, 3).Mul4(HomogRotate3D(0.7, Vec3{1, 1, 1}.Normalize())).Mul4(Scale3D(2, 0.5, 3)),
+	}
+
+	eq := absEqual(1e-4)
+	for _, m := range tests {
+		q, r := m.QR()
+		if p := q.Transpose().Mul4(q); !p.ApproxFuncEqual(Ident4(), eq) {
+			t.Errorf("%v.QR() gives a non-orthonormal Q: Qᵀ*Q = %v", m, p)
+		}
+		if p := q.Mul4(r); !p.ApproxFuncEqual(m, eq) {
+			t.Errorf("%v.QR() gives Q*R = %v", m, p)
+		}
+		for c := 0; c < 4; c++ {
+			for row := c + 1; row < 4; row++ {
+				if r.At(row, c) != 0 {
+					t.Errorf("%v.QR() gives a non-upper-triangular R (got %v)", m, r)
+				}
+			}
+		}
+	}
+}
+
+func TestMat3Sqrt(t *testing.T) {
+	rot := HomogRotate3D(1.1, Vec3{2, 1, -1}.Normalize()).Mat3()
+	spd := rot.Mul3(Diag3(Vec3{4, 9, 0.25})).Mul3(rot.Transpose())
+
+	tests := []struct {
+		Description string
+		M           Mat3
+		Expected    Mat3
+	}{
+		{"identity", Ident3(), Ident3()},
+		{"diagonal", Diag3(Vec3{4, 9, 16}), Diag3(Vec3{2, 3, 4})},
+		{"rotated", spd, rot.Mul3(Diag3(Vec3{2, 3, 0.5})).Mul3(rot.Transpose())},
+	}
+
+	eq := absEqual(1e-4)
+	for _, c := range tests {
+		r, ok := c.M.Sqrt()
+		if !ok {
+			t.Errorf("%v failed: Sqrt(%v) was not ok", c.Description, c.M)
+			continue
+		}
+		if !r.ApproxFuncEqual(c.Expected, eq) {
+			t.Errorf("%v failed: Sqrt(%v) != %v (got %v)", c.Description, c.M, c.Expected, r)
+		}
+		if !r.Mul3(r).ApproxFuncEqual(c.M, eq) {
+			t.Errorf("%v failed: Sqrt(M)*Sqrt(M) != %v (got %v)", c.Description, c.M, r.Mul3(r))
+		}
+	}
+
+	notSPD := []Mat3{
+		Diag3(Vec3{1, -1, 1}),
+		Diag3(Vec3{1, 0, 1}),
+		{1, 2, 0, 0, 1, 0, 0, 0, 1},
+	}
+	for _, m := range notSPD {
+		if r, ok := m.Sqrt(); ok {
+			t.Errorf("Sqrt(%v) should not be ok for a matrix that isn't SPD (got %v)", m, r)
+		}
+	}
+}
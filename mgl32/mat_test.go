@@ -6,6 +6,7 @@ package mgl32
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 	"time"
@@ -223,6 +224,34 @@ func TestMatColsSquare(t *testing.T) {
 	}
 }
 
+func TestMatFromRowsFromCols(t *testing.T) {
+	t.Parallel()
+
+	v0 := Vec4{1, 2, 3, 4}
+	v1 := Vec4{5, 6, 7, 8}
+	v2 := Vec4{9, 10, 11, 12}
+	v3 := Vec4{13, 14, 15, 16}
+
+	fromRows := Mat4FromRows(v0, v1, v2, v3)
+	fromCols := Mat4FromCols(v0, v1, v2, v3)
+
+	if fromRows != fromCols.Transpose() {
+		t.Errorf("Mat4FromRows is not the transpose of Mat4FromCols. Got: %v. Expected: %v", fromRows, fromCols.Transpose())
+	}
+
+	expected := Mat4{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	if fromCols != expected {
+		t.Errorf("Mat4FromCols does not match column major storage. Got: %v. Expected: %v", fromCols, expected)
+	}
+
+	m1 := Mat2x3FromRows(Vec3{1, 2, 3}, Vec3{4, 5, 6})
+	m2 := Mat3x2FromCols(Vec3{1, 2, 3}, Vec3{4, 5, 6})
+
+	if m1 != m2.Transpose() {
+		t.Errorf("Mat2x3FromRows is not the transpose of Mat3x2FromCols. Got: %v. Expected: %v", m1, m2.Transpose())
+	}
+}
+
 func TestTransposeTall(t *testing.T) {
 	t.Parallel()
 
@@ -325,6 +354,143 @@ func TestDiagTrace(t *testing.T) {
 	}
 }
 
+func TestDiagRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	v3 := Vec3{2, -3, 5}
+	m3 := Diag3(v3)
+	if d := m3.Diag(); d != v3 {
+		t.Errorf("Diag3(%v).Diag() != %v (got %v)", v3, v3, d)
+	}
+
+	v4 := Vec4{1, 2, 3, 4}
+	m4 := Diag4(v4)
+	if d := m4.Diag(); d != v4 {
+		t.Errorf("Diag4(%v).Diag() != %v (got %v)", v4, v4, d)
+	}
+	if d := m4.Diagonal(); d != v4 {
+		t.Errorf("Diag4(%v).Diagonal() != %v (got %v)", v4, v4, d)
+	}
+
+	for row := 0; row < 4; row++ {
+		for col := 0; col < 4; col++ {
+			if row != col && m4.At(row, col) != 0 {
+				t.Errorf("Diag4(%v) has non-zero off-diagonal element at (%d,%d): %v", v4, row, col, m4.At(row, col))
+			}
+		}
+	}
+
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			if row != col && m3.At(row, col) != 0 {
+				t.Errorf("Diag3(%v) has non-zero off-diagonal element at (%d,%d): %v", v3, row, col, m3.At(row, col))
+			}
+		}
+	}
+}
+
+func TestMatAdjugate(t *testing.T) {
+	m3s := []Mat3{
+		{2, 0, 1, -1, 3, 2, 4, 1, 5},
+		{1, 2, 3, 4, 5, 6, 7, 8, 9}, // singular
+		{},
+	}
+	for _, m := range m3s {
+		e := Ident3().Mul(m.Det())
+		if r := m.Mul3(m.Adjugate()); !r.ApproxFuncEqual(e, absEqual(1e-4)) {
+			t.Errorf("%v.Mul3(%v.Adjugate()) != %v (got %v)", m, m, e, r)
+		}
+		if r := m.Adjugate().Mul3(m); !r.ApproxFuncEqual(e, absEqual(1e-4)) {
+			t.Errorf("%v.Adjugate().Mul3(%v) != %v (got %v)", m, m, e, r)
+		}
+	}
+
+	m4s := []Mat4{
+		{2, 0, 1, 3, -1, 3, 2, 0, 4, 1, 5, -2, 0, 2, 1, 1},
+		{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, // singular
+		Scale3D(2, 0, 3), // singular
+	}
+	for _, m := range m4s {
+		e := Ident4().Mul(m.Det())
+		if r := m.Mul4(m.Adjugate()); !r.ApproxFuncEqual(e, absEqual(1e-3)) {
+			t.Errorf("%v.Mul4(%v.Adjugate()) != %v (got %v)", m, m, e, r)
+		}
+		if r := m.Adjugate().Mul4(m); !r.ApproxFuncEqual(e, absEqual(1e-3)) {
+			t.Errorf("%v.Adjugate().Mul4(%v) != %v (got %v)", m, m, e, r)
+		}
+	}
+
+	// A singular matrix still has a nonzero adjugate
+	if r, e := Scale3D(2, 0, 3).Adjugate(), (Mat4{5: 6}); !r.ApproxFuncEqual(e, absEqual(1e-6)) {
+		t.Errorf("Scale3D(2, 0, 3).Adjugate() != %v (got %v)", e, r)
+	}
+}
+
+func TestMat4ArrayLayout(t *testing.T) {
+	m := Mat4FromRows(
+		Vec4{1, 2, 3, 4},
+		Vec4{5, 6, 7, 8},
+		Vec4{9, 10, 11, 12},
+		Vec4{13, 14, 15, 16},
+	)
+
+	rowMajor := [16]float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	if r := m.ToArrayRowMajor(); r != rowMajor {
+		t.Errorf("ToArrayRowMajor() != %v (got %v)", rowMajor, r)
+	}
+
+	colMajor := [16]float32{1, 5, 9, 13, 2, 6, 10, 14, 3, 7, 11, 15, 4, 8, 12, 16}
+	if r := m.ToArrayColMajor(); r != colMajor {
+		t.Errorf("ToArrayColMajor() != %v (got %v)", colMajor, r)
+	}
+
+	// The two layouts are transposes of each other
+	if r := Mat4(m.ToArrayRowMajor()); r != Mat4(m.ToArrayColMajor()).Transpose() {
+		t.Errorf("ToArrayRowMajor() is not the transpose of ToArrayColMajor() (got %v)", r)
+	}
+
+	if r := Mat4FromArrayRowMajor(rowMajor); r != m {
+		t.Errorf("Mat4FromArrayRowMajor(%v) != %v (got %v)", rowMajor, m, r)
+	}
+	if r := Mat4FromArrayColMajor(colMajor); r != m {
+		t.Errorf("Mat4FromArrayColMajor(%v) != %v (got %v)", colMajor, m, r)
+	}
+}
+
+func TestMatLerp(t *testing.T) {
+	a4 := Ident4()
+	b4 := Translate3D(2, 4, 6).Mul4(Scale3D(3, 3, 3))
+	mid4 := Mat4{2, 0, 0, 0, 0, 2, 0, 0, 0, 0, 2, 0, 1, 2, 3, 1}
+
+	tests4 := []struct {
+		T        float32
+		Expected Mat4
+	}{
+		{0, a4}, {0.5, mid4}, {1, b4},
+	}
+	for _, c := range tests4 {
+		if r := a4.Lerp(b4, c.T); !r.ApproxEqual(c.Expected) {
+			t.Errorf("Mat4.Lerp(%v, %v) != %v (got %v)", b4, c.T, c.Expected, r)
+		}
+	}
+
+	a3 := Mat3{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	b3 := Mat3{3, 2, 1, 0, -1, -2, -3, -4, -5}
+	mid3 := Mat3{2, 2, 2, 2, 2, 2, 2, 2, 2}
+
+	tests3 := []struct {
+		T        float32
+		Expected Mat3
+	}{
+		{0, a3}, {0.5, mid3}, {1, b3},
+	}
+	for _, c := range tests3 {
+		if r := a3.Lerp(b3, c.T); !r.ApproxEqual(c.Expected) {
+			t.Errorf("Mat3.Lerp(%v, %v) != %v (got %v)", b3, c.T, c.Expected, r)
+		}
+	}
+}
+
 func TestMatAbs(t *testing.T) {
 	t.Parallel()
 
@@ -338,6 +504,40 @@ func TestMatAbs(t *testing.T) {
 	}
 }
 
+func TestMatNorm(t *testing.T) {
+	m3 := Mat3FromRows(
+		Vec3{1, -2, 3},
+		Vec3{0, 4, -1},
+		Vec3{-2, 2, 2},
+	)
+	// 1+4+9 + 0+16+1 + 4+4+4 = 43
+	if r := m3.Norm(); !FloatEqual(r, float32(math.Sqrt(43))) {
+		t.Errorf("Mat3.Norm() != %v (got %v)", math.Sqrt(43), r)
+	}
+	// Row sums are 6, 5 and 6
+	if r := m3.NormInf(); r != 6 {
+		t.Errorf("Mat3.NormInf() != %v (got %v)", 6, r)
+	}
+
+	m4 := Mat4FromRows(
+		Vec4{1, 0, 0, 2},
+		Vec4{0, -3, 0, 0},
+		Vec4{0, 0, 1, -4},
+		Vec4{0, 0, 0, 1},
+	)
+	// 1+4 + 9 + 1+16 + 1 = 32
+	if r := m4.Norm(); !FloatEqual(r, float32(math.Sqrt(32))) {
+		t.Errorf("Mat4.Norm() != %v (got %v)", math.Sqrt(32), r)
+	}
+	if r := m4.NormInf(); r != 5 {
+		t.Errorf("Mat4.NormInf() != %v (got %v)", 5, r)
+	}
+
+	if r := Ident3().Norm(); !FloatEqual(r, float32(math.Sqrt(3))) {
+		t.Errorf("Ident3().Norm() != %v (got %v)", math.Sqrt(3), r)
+	}
+}
+
 func TestString(t *testing.T) {
 	m := Ident4()
 
@@ -0,0 +1,69 @@
+package matstack
+
+import (
+	"errors"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// An InverseMatStack is a companion to MatStack that keeps track of the inverse
+// of the accumulated transform instead of the transform itself. If every
+// operation on a MatStack is mirrored on an InverseMatStack, Peek returns the
+// inverse of the MatStack's top. In a scenegraph, that is the matrix taking
+// world space points into the current node's local space.
+//
+// The inverse is updated incrementally: each multiplication only inverts the
+// matrix being applied, never the accumulated transform.
+type InverseMatStack []mgl32.Mat4
+
+func NewInverseMatStack() *InverseMatStack {
+	return &InverseMatStack{mgl32.Ident4()}
+}
+
+// Copies the top element and pushes it on the stack.
+func (ms *InverseMatStack) Push() {
+	(*ms) = append(*ms, (*ms)[len(*ms)-1])
+}
+
+// Removes the first element of the matrix from the stack, if there is only one element left
+// there is an error.
+func (ms *InverseMatStack) Pop() error {
+	if len(*ms) == 1 {
+		return errors.New("Cannot pop from inverse mat stack, at minimum stack length of 1")
+	}
+	(*ms) = (*ms)[:len(*ms)-1]
+
+	return nil
+}
+
+// Mirrors MatStack.RightMul: the accumulated transform T becomes T*m,
+// so the stored inverse becomes m^-1 * T^-1.
+//
+// If m is singular, the top becomes the zero matrix.
+func (ms *InverseMatStack) RightMul(m mgl32.Mat4) {
+	(*ms)[len(*ms)-1] = m.Inv().Mul4((*ms)[len(*ms)-1])
+}
+
+// Mirrors MatStack.LeftMul: the accumulated transform T becomes m*T,
+// so the stored inverse becomes T^-1 * m^-1.
+//
+// If m is singular, the top becomes the zero matrix.
+func (ms *InverseMatStack) LeftMul(m mgl32.Mat4) {
+	(*ms)[len(*ms)-1] = (*ms)[len(*ms)-1].Mul4(m.Inv())
+}
+
+// Returns the top element, the inverse of the accumulated transform.
+func (ms *InverseMatStack) Peek() mgl32.Mat4 {
+	return (*ms)[len(*ms)-1]
+}
+
+// Mirrors MatStack.Load, rewriting the top element of the stack with the
+// inverse of m. This is the only operation that inverts a full transform.
+func (ms *InverseMatStack) Load(m mgl32.Mat4) {
+	(*ms)[len(*ms)-1] = m.Inv()
+}
+
+// A shortcut for Load(mgl.Ident4())
+func (ms *InverseMatStack) LoadIdent() {
+	(*ms)[len(*ms)-1] = mgl32.Ident4()
+}
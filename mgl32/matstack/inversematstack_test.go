@@ -0,0 +1,73 @@
+package matstack
+
+import (
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func TestInverseMatStack(t *testing.T) {
+	stack := NewMatStack()
+	inv := NewInverseMatStack()
+
+	absEqual := func(a, b float32) bool {
+		return mgl32.Abs(a-b) <= 1e-4
+	}
+	check := func(desc string) {
+		if r := inv.Peek().Mul4(stack.Peek()); !r.ApproxFuncEqual(mgl32.Ident4(), absEqual) {
+			t.Errorf("%v: inverse top times top != identity (got %v)", desc, r)
+		}
+	}
+
+	check("initial")
+
+	ops := []struct {
+		Description string
+		M           mgl32.Mat4
+		Left        bool
+	}{
+		{"translate", mgl32.Translate3D(1, 2, 3), false},
+		{"rotate", mgl32.HomogRotate3DY(mgl32.DegToRad(30)), false},
+		{"scale", mgl32.Scale3D(2, 0.5, 4), false},
+		{"left rotate", mgl32.HomogRotate3DX(mgl32.DegToRad(-45)), true},
+	}
+
+	for _, op := range ops {
+		stack.Push()
+		inv.Push()
+		if op.Left {
+			stack.LeftMul(op.M)
+			inv.LeftMul(op.M)
+		} else {
+			stack.RightMul(op.M)
+			inv.RightMul(op.M)
+		}
+		check(op.Description)
+	}
+
+	if len(*inv) != len(*stack) {
+		t.Errorf("Inverse stack length %d != mat stack length %d", len(*inv), len(*stack))
+	}
+
+	for len(*stack) > 1 {
+		stack.Pop()
+		if err := inv.Pop(); err != nil {
+			t.Errorf("Pop is unsuccessful: %v", err)
+		}
+		check("after pop")
+	}
+
+	if err := inv.Pop(); err == nil {
+		t.Errorf("Popping the last element does not return error as expected")
+	}
+
+	m := mgl32.Translate3D(4, 5, 6).Mul4(mgl32.HomogRotate3DZ(1))
+	stack.Load(m)
+	inv.Load(m)
+	check("load")
+
+	inv.LoadIdent()
+	if !inv.Peek().ApproxEqual(mgl32.Ident4()) {
+		t.Errorf("LoadIdent did not load the identity (got %v)", inv.Peek())
+	}
+}
@@ -0,0 +1,84 @@
+package matstack
+
+import (
+	"errors"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// A Mat3Stack is the 2D counterpart of MatStack. It holds
+// homogeneous 3x3 matrices, which is all that's needed for
+// sprite or UI hierarchies.
+type Mat3Stack []mgl32.Mat3
+
+func NewMat3Stack() *Mat3Stack {
+	return &Mat3Stack{mgl32.Ident3()}
+}
+
+// Copies the top element and pushes it on the stack.
+func (ms *Mat3Stack) Push() {
+	(*ms) = append(*ms, (*ms)[len(*ms)-1])
+}
+
+// Pushes a copy of the top element right multiplied by a 2D translation.
+func (ms *Mat3Stack) PushTranslate2D(tx, ty float32) {
+	ms.Push()
+	ms.RightMul(mgl32.Translate2D(tx, ty))
+}
+
+// Pushes a copy of the top element right multiplied by a 2D rotation
+// of angle radians about the origin.
+func (ms *Mat3Stack) PushRotate2D(angle float32) {
+	ms.Push()
+	ms.RightMul(mgl32.HomogRotate2D(angle))
+}
+
+// Pushes a copy of the top element right multiplied by a 2D scale.
+func (ms *Mat3Stack) PushScale2D(scaleX, scaleY float32) {
+	ms.Push()
+	ms.RightMul(mgl32.Scale2D(scaleX, scaleY))
+}
+
+// Removes the first element of the matrix from the stack, if there is only one element left
+// there is an error.
+func (ms *Mat3Stack) Pop() error {
+	if len(*ms) == 1 {
+		return errors.New("Cannot pop from mat stack, at minimum stack length of 1")
+	}
+	(*ms) = (*ms)[:len(*ms)-1]
+
+	return nil
+}
+
+// Right multiplies the current top of the matrix by the
+// argument.
+func (ms *Mat3Stack) RightMul(m mgl32.Mat3) {
+	(*ms)[len(*ms)-1] = (*ms)[len(*ms)-1].Mul3(m)
+}
+
+// Left multiplies the current top of the matrix by the
+// argument.
+func (ms *Mat3Stack) LeftMul(m mgl32.Mat3) {
+	(*ms)[len(*ms)-1] = m.Mul3((*ms)[len(*ms)-1])
+}
+
+// Returns the top element.
+func (ms *Mat3Stack) Peek() mgl32.Mat3 {
+	return (*ms)[len(*ms)-1]
+}
+
+// Returns the size of the matrix stack. This value will never be less
+// than 1.
+func (ms *Mat3Stack) Len() int {
+	return len(*ms)
+}
+
+// Rewrites the top element of the stack with m
+func (ms *Mat3Stack) Load(m mgl32.Mat3) {
+	(*ms)[len(*ms)-1] = m
+}
+
+// A shortcut for Load(mgl.Ident3())
+func (ms *Mat3Stack) LoadIdent() {
+	(*ms)[len(*ms)-1] = mgl32.Ident3()
+}
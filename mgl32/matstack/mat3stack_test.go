@@ -0,0 +1,94 @@
+package matstack
+
+import (
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func TestMat3StackNew(t *testing.T) {
+	stack := NewMat3Stack()
+
+	if !(*stack)[0].ApproxEqual(mgl32.Ident3()) {
+		t.Errorf("Cannot construct stack correctly")
+	}
+}
+
+func TestMat3StackPushPopPeek(t *testing.T) {
+	stack := NewMat3Stack()
+
+	if !stack.Peek().ApproxEqual(mgl32.Ident3()) {
+		t.Errorf("Peek not working")
+	}
+
+	stack.Push()
+	stack.RightMul(mgl32.HomogRotate2D(mgl32.DegToRad(90)))
+
+	if !stack.Peek().ApproxEqual(mgl32.HomogRotate2D(mgl32.DegToRad(90))) {
+		t.Errorf("Peek not working")
+	}
+
+	if stack.Len() != 2 {
+		t.Errorf("Peek alters stack length")
+	}
+
+	if err := stack.Pop(); err != nil {
+		t.Errorf("Pop is unsuccessful")
+	}
+
+	if stack.Len() != 1 {
+		t.Errorf("Pop does not actually shorten stack")
+	}
+
+	if !stack.Peek().ApproxEqual(mgl32.Ident3()) {
+		t.Errorf("Pop does not restore the previous top")
+	}
+
+	if err := stack.Pop(); err == nil {
+		t.Errorf("Popping stack with 1 element does not return error as expected")
+	}
+}
+
+func TestMat3StackConvenience(t *testing.T) {
+	stack := NewMat3Stack()
+
+	trans := mgl32.Translate2D(4, 5)
+	rot := mgl32.HomogRotate2D(mgl32.DegToRad(90))
+	scale := mgl32.Scale2D(2, 3)
+
+	stack.PushTranslate2D(4, 5)
+	stack.PushRotate2D(mgl32.DegToRad(90))
+
+	if !stack.Peek().ApproxEqualThreshold(trans.Mul3(rot), 1e-4) {
+		t.Errorf("Stack does not multiply first two pushes correctly")
+	}
+
+	stack.PushScale2D(2, 3)
+
+	if !stack.Peek().ApproxEqualThreshold(trans.Mul3(rot).Mul3(scale), 1e-4) {
+		t.Errorf("Stack does not multiply third push correctly")
+	}
+
+	if stack.Len() != 4 {
+		t.Errorf("Stack length after three pushes != 4 (got %d)", stack.Len())
+	}
+
+	p := stack.Peek().Mul3x1(mgl32.Vec3{1, 1, 1})
+	if !p.ApproxEqualThreshold(mgl32.Vec3{1, 7, 1}, 1e-4) {
+		t.Errorf("Stack transforms point (1,1) to %v, expected (1,7)", p)
+	}
+
+	stack.Pop()
+	stack.Pop()
+	stack.LeftMul(scale)
+
+	if !stack.Peek().ApproxEqualThreshold(scale.Mul3(trans), 1e-4) {
+		t.Errorf("LeftMul does not multiply correctly")
+	}
+
+	stack.LoadIdent()
+
+	if !stack.Peek().ApproxEqual(mgl32.Ident3()) {
+		t.Errorf("LoadIdent does not load the identity")
+	}
+}
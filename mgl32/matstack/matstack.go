@@ -2,6 +2,7 @@ package matstack
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/go-gl/mathgl/mgl32"
 )
@@ -57,3 +58,47 @@ func (ms *MatStack) Load(m mgl32.Mat4) {
 func (ms *MatStack) LoadIdent() {
 	(*ms)[len(*ms)-1] = mgl32.Ident4()
 }
+
+// Calls fn on every matrix in the stack, from the bottom (depth 0) to the
+// top. Iteration stops early if fn returns false.
+func (ms *MatStack) ForEach(fn func(depth int, m mgl32.Mat4) bool) {
+	for i, m := range *ms {
+		if !fn(i, m) {
+			return
+		}
+	}
+}
+
+// A BoundedMatStack is a MatStack with a maximum depth. Pushing
+// past the maximum depth fails instead of growing the stack, which
+// guards against runaway recursion in things like scenegraph traversal.
+type BoundedMatStack struct {
+	MatStack
+	maxDepth int
+}
+
+// Creates a matrix stack that can hold at most maxDepth matrices,
+// including the initial identity. A maxDepth smaller than 1 is
+// treated as 1.
+func NewBoundedMatStack(maxDepth int) *BoundedMatStack {
+	if maxDepth < 1 {
+		maxDepth = 1
+	}
+	return &BoundedMatStack{MatStack: *NewMatStack(), maxDepth: maxDepth}
+}
+
+// Copies the top element and pushes it on the stack. If the stack is
+// already at its maximum depth, the stack is left untouched and an error is returned.
+func (ms *BoundedMatStack) Push() error {
+	if len(ms.MatStack) >= ms.maxDepth {
+		return fmt.Errorf("Cannot push to mat stack, at maximum stack depth of %d", ms.maxDepth)
+	}
+	ms.MatStack.Push()
+
+	return nil
+}
+
+// Returns the maximum number of matrices the stack can hold.
+func (ms *BoundedMatStack) MaxDepth() int {
+	return ms.maxDepth
+}
@@ -0,0 +1,87 @@
+package matstack
+
+import (
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func TestBoundedMatStack(t *testing.T) {
+	stack := NewBoundedMatStack(3)
+
+	if !stack.Peek().ApproxEqual(mgl32.Ident4()) {
+		t.Errorf("Cannot construct bounded stack correctly")
+	}
+
+	stack.RightMul(mgl32.Translate3D(1, 2, 3))
+
+	for i := 0; i < 2; i++ {
+		if err := stack.Push(); err != nil {
+			t.Errorf("Push %d within the depth limit returned error: %v", i, err)
+		}
+	}
+
+	if err := stack.Push(); err == nil {
+		t.Errorf("Pushing past the depth limit does not return error as expected")
+	}
+
+	if len(stack.MatStack) != 3 {
+		t.Errorf("Failed push altered stack length, expected 3 (got %d)", len(stack.MatStack))
+	}
+
+	if !stack.Peek().ApproxEqual(mgl32.Translate3D(1, 2, 3)) {
+		t.Errorf("Push does not copy the top element")
+	}
+
+	if err := stack.Pop(); err != nil {
+		t.Errorf("Pop returned error: %v", err)
+	}
+
+	if err := stack.Push(); err != nil {
+		t.Errorf("Push after Pop returned error: %v", err)
+	}
+
+	if stack.MaxDepth() != 3 {
+		t.Errorf("MaxDepth != 3 (got %d)", stack.MaxDepth())
+	}
+}
+
+func TestMatStackForEach(t *testing.T) {
+	stack := NewMatStack()
+	expected := []mgl32.Mat4{mgl32.Ident4()}
+
+	for _, m := range []mgl32.Mat4{mgl32.Translate3D(1, 2, 3), mgl32.HomogRotate3DY(1), mgl32.Scale3D(2, 2, 2)} {
+		stack.Push()
+		stack.RightMul(m)
+		expected = append(expected, stack.Peek())
+	}
+
+	var got []mgl32.Mat4
+	stack.ForEach(func(depth int, m mgl32.Mat4) bool {
+		if depth != len(got) {
+			t.Errorf("ForEach passed depth %d, expected %d", depth, len(got))
+		}
+		got = append(got, m)
+		return true
+	})
+
+	if len(got) != len(expected) {
+		t.Fatalf("ForEach visited %d matrices, expected %d", len(got), len(expected))
+	}
+
+	for i := range expected {
+		if !got[i].ApproxEqual(expected[i]) {
+			t.Errorf("ForEach matrix at depth %d != %v (got %v)", i, expected[i], got[i])
+		}
+	}
+
+	count := 0
+	stack.ForEach(func(depth int, m mgl32.Mat4) bool {
+		count++
+		return depth < 1
+	})
+
+	if count != 2 {
+		t.Errorf("ForEach did not stop early, visited %d matrices (expected 2)", count)
+	}
+}
@@ -119,3 +119,43 @@ func binLog(val int) (int, bool) {
 
 	return l, exact
 }
+
+// MatPool is a pool of temporary Mat4s, for hot paths that would otherwise
+// allocate many short-lived matrices per frame (e.g. per-bone transforms in
+// an animation system). It is backed by a sync.Pool, so it is safe for
+// concurrent use and the garbage collector may still drop idle matrices.
+// The zero value is ready to use.
+//
+// A matrix obtained from Get belongs to the caller until it is passed to
+// Release. After Release, the pool may hand the same *Mat4 to another caller
+// (possibly on another goroutine), so the releasing code must not read or
+// write it again, and must not keep any other pointer to it. In particular,
+// do not Release a matrix that is still referenced from a longer-lived
+// structure, and do not Release the same matrix twice. To keep a pooled
+// result, copy it out by value (m := *pooled) before releasing.
+//
+// If DisableMemoryPooling has been called, Release simply drops the matrix.
+type MatPool struct {
+	pool sync.Pool
+}
+
+// Get returns a zeroed *Mat4 from the pool, allocating one if the pool is
+// empty.
+func (p *MatPool) Get() *Mat4 {
+	if m, ok := p.pool.Get().(*Mat4); ok {
+		*m = Mat4{}
+		return m
+	}
+
+	return new(Mat4)
+}
+
+// Release returns m to the pool for reuse. m must not be used after this
+// call. Releasing nil is a no-op.
+func (p *MatPool) Release(m *Mat4) {
+	if m == nil || !shouldPool {
+		return
+	}
+
+	p.pool.Put(m)
+}
@@ -73,3 +73,66 @@ func BenchmarkBinLogSmall(b *testing.B) {
 		_, _ = binLog(10)
 	}
 }
+
+func TestMatPool(t *testing.T) {
+	var p MatPool
+
+	m := p.Get()
+	if m == nil || *m != (Mat4{}) {
+		t.Fatalf("MatPool.Get() != zero matrix (got %v)", m)
+	}
+
+	*m = Ident4()
+	p.Release(m)
+
+	// Whether or not the pool hands back the same pointer, it must be zeroed.
+	if m2 := p.Get(); *m2 != (Mat4{}) {
+		t.Errorf("MatPool.Get() after Release != zero matrix (got %v)", *m2)
+	}
+
+	p.Release(nil)
+}
+
+// boneSink keeps the per-bone matrices in the benchmarks reachable, so that
+// naively allocated ones escape to the heap as they would in a real skeleton.
+var boneSink []*Mat4
+
+func BenchmarkMatPoolTransforms(b *testing.B) {
+	var p MatPool
+	local := HomogRotate3DY(0.1).Mul4(Translate3D(0, 1, 0))
+	boneSink = make([]*Mat4, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		parent := Ident4()
+		for j := range boneSink {
+			world := p.Get()
+			*world = parent.Mul4(local)
+			boneSink[j] = world
+			parent = *world
+		}
+
+		for j, m := range boneSink {
+			p.Release(m)
+			boneSink[j] = nil
+		}
+	}
+}
+
+func BenchmarkMatAllocTransforms(b *testing.B) {
+	local := HomogRotate3DY(0.1).Mul4(Translate3D(0, 1, 0))
+	boneSink = make([]*Mat4, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		parent := Ident4()
+		for j := range boneSink {
+			world := new(Mat4)
+			*world = parent.Mul4(local)
+			boneSink[j] = world
+			parent = *world
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mglcolor converts between the Vec4 colors of its parent package and the
+// types of the image/color package. It is separate from the parent package so that
+// only programs that need these conversions depend on image/color.
+//
+// As in the parent package, a Vec4 is treated as an RGBA color with the color channels
+// in X, Y, and Z, the alpha (opacity) in W, and straight (not premultiplied) alpha.
+package mglcolor
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// RGBA converts the color to an 8-bit color.RGBA, clamping every channel to [0,1]
+// first. The vector is taken to hold straight alpha, while color.RGBA is
+// premultiplied, so the color channels are premultiplied as part of the conversion.
+func RGBA(v mgl32.Vec4) color.RGBA {
+	return RGBAGamma(v, 1)
+}
+
+// RGBAGamma is like RGBA, but gamma-encodes the red, green, and blue channels by
+// raising them to the power 1/gamma before quantizing. A gamma of 2.2 approximates
+// the conversion from linear light to sRGB; a gamma of 1 leaves them unchanged.
+func RGBAGamma(v mgl32.Vec4, gamma float32) color.RGBA {
+	v = encodeGamma(v.ClampColor(), gamma).Premultiply()
+	return color.RGBA{quantize8(v[0]), quantize8(v[1]), quantize8(v[2]), quantize8(v[3])}
+}
+
+// NRGBA converts the color to an 8-bit color.NRGBA, clamping every channel to [0,1]
+// first. Like the Vec4, color.NRGBA holds straight alpha, so unlike RGBA this keeps
+// the color of translucent pixels at full precision.
+func NRGBA(v mgl32.Vec4) color.NRGBA {
+	return NRGBAGamma(v, 1)
+}
+
+// NRGBAGamma is like NRGBA, but gamma-encodes the red, green, and blue channels as
+// RGBAGamma does.
+func NRGBAGamma(v mgl32.Vec4, gamma float32) color.NRGBA {
+	v = encodeGamma(v.ClampColor(), gamma)
+	return color.NRGBA{quantize8(v[0]), quantize8(v[1]), quantize8(v[2]), quantize8(v[3])}
+}
+
+// FromColor converts any color.Color to a Vec4 with channels in [0,1] and straight
+// alpha. This is the inverse of RGBA and NRGBA, up to quantization. Fully
+// transparent colors become transparent black.
+func FromColor(c color.Color) mgl32.Vec4 {
+	return FromColorGamma(c, 1)
+}
+
+// FromColorGamma is like FromColor, but gamma-decodes the red, green, and blue
+// channels by raising them to the power gamma. It is the inverse of RGBAGamma and
+// NRGBAGamma.
+func FromColorGamma(c color.Color, gamma float32) mgl32.Vec4 {
+	r, g, b, a := c.RGBA()
+	if a == 0 {
+		return mgl32.Vec4{}
+	}
+
+	v := mgl32.Vec4{float32(r) / float32(a), float32(g) / float32(a), float32(b) / float32(a), float32(a) / 0xffff}
+	if gamma != 1 {
+		for i := 0; i < 3; i++ {
+			v[i] = float32(math.Pow(float64(v[i]), float64(gamma)))
+		}
+	}
+
+	return v
+}
+
+// encodeGamma raises the red, green, and blue channels of v to the power 1/gamma.
+func encodeGamma(v mgl32.Vec4, gamma float32) mgl32.Vec4 {
+	if gamma != 1 {
+		for i := 0; i < 3; i++ {
+			v[i] = float32(math.Pow(float64(v[i]), 1/float64(gamma)))
+		}
+	}
+	return v
+}
+
+func quantize8(x float32) uint8 {
+	return uint8(math.Floor(float64(x)*255 + .5))
+}
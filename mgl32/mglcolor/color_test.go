@@ -0,0 +1,97 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mglcolor
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func TestRGBA(t *testing.T) {
+	tests := []struct {
+		Color    mgl32.Vec4
+		Expected color.RGBA
+	}{
+		{mgl32.Vec4{1, 0, 0, 1}, color.RGBA{255, 0, 0, 255}},
+		{mgl32.Vec4{0.2, 0.4, 0.6, 1}, color.RGBA{51, 102, 153, 255}},
+		{mgl32.Vec4{2, -1, 0.5, 1}, color.RGBA{255, 0, 128, 255}},
+		{mgl32.Vec4{1, 1, 1, 0.5}, color.RGBA{128, 128, 128, 128}},
+		{mgl32.Vec4{1, 1, 1, 0}, color.RGBA{0, 0, 0, 0}},
+	}
+
+	for _, c := range tests {
+		if r := RGBA(c.Color); r != c.Expected {
+			t.Errorf("RGBA(%v) != %v (got %v)", c.Color, c.Expected, r)
+		}
+	}
+
+	v := mgl32.Vec4{0.5, 0.25, 1, 1}
+	if r, e := RGBAGamma(v, 2.2), (color.RGBA{186, 136, 255, 255}); r != e {
+		t.Errorf("RGBAGamma(%v, 2.2) != %v (got %v)", v, e, r)
+	}
+}
+
+func TestNRGBA(t *testing.T) {
+	tests := []struct {
+		Color    mgl32.Vec4
+		Expected color.NRGBA
+	}{
+		{mgl32.Vec4{1, 0, 0, 1}, color.NRGBA{255, 0, 0, 255}},
+		{mgl32.Vec4{2, -1, 0.5, 1}, color.NRGBA{255, 0, 128, 255}},
+		{mgl32.Vec4{0.2, 0.4, 0.6, 0.5}, color.NRGBA{51, 102, 153, 128}},
+		{mgl32.Vec4{1, 1, 1, 0}, color.NRGBA{255, 255, 255, 0}},
+	}
+
+	for _, c := range tests {
+		if r := NRGBA(c.Color); r != c.Expected {
+			t.Errorf("NRGBA(%v) != %v (got %v)", c.Color, c.Expected, r)
+		}
+	}
+
+	v := mgl32.Vec4{0.5, 0.25, 1, 0.5}
+	if r, e := NRGBAGamma(v, 2.2), (color.NRGBA{186, 136, 255, 128}); r != e {
+		t.Errorf("NRGBAGamma(%v, 2.2) != %v (got %v)", v, e, r)
+	}
+}
+
+func TestColorRoundTrip(t *testing.T) {
+	for _, c := range []color.RGBA{
+		{0, 0, 0, 255},
+		{255, 255, 255, 255},
+		{12, 200, 99, 255},
+		{100, 50, 0, 128},
+	} {
+		if r := RGBA(FromColor(c)); r != c {
+			t.Errorf("RGBA(FromColor(%v)) != %v (got %v)", c, c, r)
+		}
+
+		if r := RGBAGamma(FromColorGamma(c, 2.2), 2.2); r != c {
+			t.Errorf("RGBAGamma(FromColorGamma(%v, 2.2), 2.2) != %v (got %v)", c, c, r)
+		}
+	}
+
+	for _, c := range []color.NRGBA{{12, 200, 99, 255}, {100, 50, 0, 128}, {255, 1, 77, 3}} {
+		if r := NRGBA(FromColor(c)); r != c {
+			t.Errorf("NRGBA(FromColor(%v)) != %v (got %v)", c, c, r)
+		}
+	}
+
+	for _, v := range []mgl32.Vec4{{0.2, 0.4, 0.6, 1}, {1, 0.5, 0, 0.5}} {
+		if r := FromColor(RGBA(v)); !r.EqualThreshold(v, 1.0/255) {
+			t.Errorf("FromColor(RGBA(%v)) != %v (got %v)", v, v, r)
+		}
+	}
+
+	// Other color models are converted through their RGBA method
+	if r, e := FromColor(color.Gray{255}), (mgl32.Vec4{1, 1, 1, 1}); r != e {
+		t.Errorf("FromColor(color.Gray{255}) != %v (got %v)", e, r)
+	}
+
+	if r := FromColor(color.RGBA{}); r != (mgl32.Vec4{}) {
+		t.Errorf("FromColor(color.RGBA{}) != %v (got %v)", mgl32.Vec4{}, r)
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 || !mglsimd
+// +build !amd64 !mglsimd
+
+package mgl32
+
+func mul4(m1, m2 Mat4) Mat4 {
+	return mul4Generic(m1, m2)
+}
+
+func mul4Into(dst, a, b *Mat4) {
+	*dst = mul4Generic(*a, *b)
+}
@@ -0,0 +1,27 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build mglsimd
+// +build mglsimd
+
+package mgl32
+
+func mul4(m1, m2 Mat4) Mat4 {
+	var m Mat4
+	mul4SSE(&m, &m1, &m2)
+	return m
+}
+
+func mul4Into(dst, a, b *Mat4) {
+	mul4SSE(dst, a, b)
+}
+
+// mul4SSE computes dst = a * b with SSE. Each column of dst is accumulated as
+// a.Col(0)*b[0] + a.Col(1)*b[1] + ... in the same order as mul4Generic, which
+// rounds every product just as the SSE multiplies do rather than fusing it into an
+// FMA, so the results are bit-for-bit identical. dst must not alias a or b, since its
+// columns are stored while b is still being read.
+//
+//go:noescape
+func mul4SSE(dst, a, b *Mat4)
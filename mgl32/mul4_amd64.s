@@ -0,0 +1,46 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build mglsimd
+// +build mglsimd
+
+#include "textflag.h"
+
+// COLUMN computes column off/16 of the product into X4, using X0-X3 as the
+// columns of a and X5 as scratch, then stores it into dst.
+#define COLUMN(off) \
+	MOVSS  (off+0)(DX), X4  \
+	SHUFPS $0x00, X4, X4    \
+	MULPS  X0, X4           \
+	MOVSS  (off+4)(DX), X5  \
+	SHUFPS $0x00, X5, X5    \
+	MULPS  X1, X5           \
+	ADDPS  X5, X4           \
+	MOVSS  (off+8)(DX), X5  \
+	SHUFPS $0x00, X5, X5    \
+	MULPS  X2, X5           \
+	ADDPS  X5, X4           \
+	MOVSS  (off+12)(DX), X5 \
+	SHUFPS $0x00, X5, X5    \
+	MULPS  X3, X5           \
+	ADDPS  X5, X4           \
+	MOVUPS X4, off(DI)
+
+// func mul4SSE(dst, a, b *Mat4)
+TEXT ·mul4SSE(SB), NOSPLIT, $0-24
+	MOVQ dst+0(FP), DI
+	MOVQ a+8(FP), SI
+	MOVQ b+16(FP), DX
+
+	MOVUPS 0(SI), X0
+	MOVUPS 16(SI), X1
+	MOVUPS 32(SI), X2
+	MOVUPS 48(SI), X3
+
+	COLUMN(0)
+	COLUMN(16)
+	COLUMN(32)
+	COLUMN(48)
+
+	RET
@@ -0,0 +1,90 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMul4MatchesGeneric(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 1000; i++ {
+		var m1, m2 Mat4
+		for j := range m1 {
+			m1[j], m2[j] = r.Float32()*200-100, r.Float32()*200-100
+		}
+
+		// Mul4 must give exactly the same result regardless of which
+		// implementation is built, not just an approximately equal one.
+		if got, expect := m1.Mul4(m2), mul4Generic(m1, m2); got != expect {
+			t.Fatalf("%v.Mul4(%v) != %v (got %v)", m1, m2, expect, got)
+		}
+	}
+}
+
+func BenchmarkMul4(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	m1, m2 := RandomAffineMat4(r), RandomAffineMat4(r)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m1 = m1.Mul4(m2)
+	}
+}
+
+func BenchmarkMul4Generic(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	m1, m2 := RandomAffineMat4(r), RandomAffineMat4(r)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m1 = mul4Generic(m1, m2)
+	}
+}
+
+func TestMul4Into(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	a, b := RandomAffineMat4(r), RandomAffineMat4(r)
+	expect := a.Mul4(b)
+
+	var dst Mat4
+	Mul4Into(&dst, a, b)
+	if dst != expect {
+		t.Errorf("Mul4Into(%v, %v) != %v (got %v)", a, b, expect, dst)
+	}
+
+	// dst aliasing a
+	m := a
+	Mul4Into(&m, m, b)
+	if m != expect {
+		t.Errorf("Mul4Into with dst aliasing a != %v (got %v)", expect, m)
+	}
+
+	// dst aliasing b
+	m = b
+	Mul4Into(&m, a, m)
+	if m != expect {
+		t.Errorf("Mul4Into with dst aliasing b != %v (got %v)", expect, m)
+	}
+
+	// dst aliasing both
+	m = a
+	Mul4Into(&m, m, m)
+	if e := a.Mul4(a); m != e {
+		t.Errorf("Mul4Into with dst aliasing a and b != %v (got %v)", e, m)
+	}
+}
+
+func BenchmarkMul4Into(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	m1, m2 := RandomAffineMat4(r), RandomAffineMat4(r)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		Mul4Into(&m1, m1, m2)
+	}
+}
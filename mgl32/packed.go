@@ -0,0 +1,52 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// PackR10G10B10 packs the vector into 32 bits in the layout of the OpenGL
+// GL_INT_2_10_10_10_REV (signed) and GL_UNSIGNED_INT_2_10_10_10_REV (unsigned) vertex
+// formats: X in the lowest 10 bits, then Y, then Z. The top 2 bits (W) are left zero.
+//
+// If signed is true, each element is clamped to [-1,1] and stored as a 10 bit two's
+// complement integer in [-511,511], using the OpenGL 4.2 normalization where -1.0,
+// 0.0 and 1.0 are all exactly representable. Otherwise each element is clamped to
+// [0,1] and stored as an unsigned integer in [0,1023]. Values are rounded to the
+// nearest step.
+func (v Vec3) PackR10G10B10(signed bool) uint32 {
+	var packed uint32
+	for i, e := range v {
+		var field uint32
+		if signed {
+			field = uint32(int32(math.Floor(float64(Clamp(e, -1, 1)*511)+0.5))) & 0x3ff
+		} else {
+			field = uint32(math.Floor(float64(Clamp(e, 0, 1)*1023) + 0.5))
+		}
+		packed |= field << uint(10*i)
+	}
+
+	return packed
+}
+
+// UnpackR10G10B10 is the inverse of Vec3.PackR10G10B10, ignoring the top 2 bits.
+// signed must match the value used for packing. In the signed case, the field
+// value -512 (which packing never produces) is clamped to -1.0 as OpenGL does.
+func UnpackR10G10B10(packed uint32, signed bool) Vec3 {
+	var v Vec3
+	for i := range v {
+		field := (packed >> uint(10*i)) & 0x3ff
+		if signed {
+			// Sign extend the 10 bit field
+			n := int32(field<<22) >> 22
+			v[i] = Clamp(float32(n)/511, -1, 1)
+		} else {
+			v[i] = float32(field) / 1023
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,68 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestPackR10G10B10(t *testing.T) {
+	tests := []struct {
+		Description string
+		V           Vec3
+		Signed      bool
+		Packed      uint32
+	}{
+		{"unsigned zero", Vec3{0, 0, 0}, false, 0},
+		{"unsigned one", Vec3{1, 1, 1}, false, 0x3fffffff},
+		{"unsigned mixed", Vec3{1, 0, 1}, false, 0x3ff003ff},
+		{"unsigned clamped", Vec3{-1, 2, 0}, false, 0x000ffc00},
+		{"signed zero", Vec3{0, 0, 0}, true, 0},
+		{"signed one", Vec3{1, 0, 0}, true, 0x1ff},
+		{"signed minus one", Vec3{0, -1, 0}, true, 0x201 << 10},
+		{"signed clamped", Vec3{0, 0, -5}, true, 0x201 << 20},
+	}
+
+	for _, c := range tests {
+		if r := c.V.PackR10G10B10(c.Signed); r != c.Packed {
+			t.Errorf("%v failed: %v.PackR10G10B10(%v) != %#08x (got %#08x)", c.Description, c.V, c.Signed, c.Packed, r)
+		}
+	}
+}
+
+func TestPackR10G10B10RoundTrip(t *testing.T) {
+	tests := []struct {
+		V      Vec3
+		Signed bool
+	}{
+		{Vec3{0, 0.5, 1}, false},
+		{Vec3{0.25, 0.75, 0.1}, false},
+		{Vec3{-1, 0, 1}, true},
+		{Vec3{-0.5, 0.5, 0.25}, true},
+		{Vec3{0.33, -0.66, 0.99}, true},
+	}
+
+	for _, c := range tests {
+		// Half a step of quantization error at most
+		step := float32(1.0 / 1023)
+		if c.Signed {
+			step = 1.0 / 511
+		}
+
+		if r := UnpackR10G10B10(c.V.PackR10G10B10(c.Signed), c.Signed); !r.EqualThreshold(c.V, step/2+1e-6) {
+			t.Errorf("UnpackR10G10B10(%v.PackR10G10B10(%v)) != itself within %v (got %v)", c.V, c.Signed, step/2, r)
+		}
+	}
+
+	// The extremes and zero are exact
+	for _, v := range []Vec3{{-1, 0, 1}, {1, -1, 0}} {
+		if r := UnpackR10G10B10(v.PackR10G10B10(true), true); r != v {
+			t.Errorf("UnpackR10G10B10(%v.PackR10G10B10(true)) != itself (got %v)", v, r)
+		}
+	}
+	if r := UnpackR10G10B10(0x200, true); r[0] != -1 {
+		t.Errorf("UnpackR10G10B10 of -512 != -1 (got %v)", r[0])
+	}
+}
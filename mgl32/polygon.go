@@ -0,0 +1,250 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"sort"
+)
+
+// PointInPolygon reports whether the point p lies inside the polygon, which is given as
+// a list of vertices with an implicit edge from the last vertex back to the first. The
+// polygon may be convex or concave, in either winding order, but should not
+// self-intersect.
+//
+// This uses the crossing number (even-odd) rule. Points lying exactly on an edge or a
+// vertex are considered inside, so the test is inclusive. A polygon with fewer than
+// 3 vertices contains no points.
+func PointInPolygon(p Vec2, polygon []Vec2) bool {
+	if len(polygon) < 3 {
+		return false
+	}
+
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[j], polygon[i]
+
+		if onSegment2D(p, a, b) {
+			return true
+		}
+
+		// Does the edge straddle the horizontal line through p, and
+		// is the crossing to the right of p?
+		if (a[1] > p[1]) != (b[1] > p[1]) {
+			x := a[0] + (p[1]-a[1])*(b[0]-a[0])/(b[1]-a[1])
+			if p[0] < x {
+				inside = !inside
+			}
+		}
+	}
+
+	return inside
+}
+
+// onSegment2D reports whether p lies on the segment from a to b, up to rounding error:
+// the sine of the angle between ab and ap may be a few machine epsilons off zero, since
+// the coordinates generally can't be represented exactly.
+func onSegment2D(p, a, b Vec2) bool {
+	ab, ap := b.Sub(a), p.Sub(a)
+	cross := ab[0]*ap[1] - ab[1]*ap[0]
+	if Abs(cross) > 4*machineEpsilon*ab.Len()*ap.Len() {
+		return false
+	}
+
+	dot := ap.Dot(ab)
+	return dot >= 0 && dot <= ab.Dot(ab)
+}
+
+// ConvexHull2D computes the convex hull of a set of points using Andrew's monotone chain
+// algorithm. The hull vertices are returned in counterclockwise order starting from
+// the point with the lowest X (and then lowest Y) coordinate.
+//
+// Duplicate points and points lying along a hull edge are not included in the
+// result. If all points are collinear, the two extreme points are returned; if all
+// points are identical, a single point is returned. The input slice is not modified.
+func ConvexHull2D(points []Vec2) []Vec2 {
+	if len(points) == 0 {
+		return nil
+	}
+
+	sorted := make(vec2Lexical, len(points))
+	copy(sorted, points)
+	sort.Sort(sorted)
+
+	// Remove duplicates
+	unique := sorted[:1]
+	for _, p := range sorted[1:] {
+		if p != unique[len(unique)-1] {
+			unique = append(unique, p)
+		}
+	}
+	if len(unique) < 3 {
+		return []Vec2(unique)
+	}
+
+	hull := make([]Vec2, 0, 2*len(unique))
+
+	// Lower hull
+	for _, p := range unique {
+		for len(hull) >= 2 && cross2D(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	// Upper hull
+	lower := len(hull) + 1
+	for i := len(unique) - 2; i >= 0; i-- {
+		p := unique[i]
+		for len(hull) >= lower && cross2D(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	// The last point is the first point again
+	return hull[:len(hull)-1]
+}
+
+// cross2D returns the Z component of the cross product (a-o)x(b-o). It is positive
+// if o, a, b make a counterclockwise turn, negative for clockwise, and zero if collinear.
+func cross2D(o, a, b Vec2) float32 {
+	return (a[0]-o[0])*(b[1]-o[1]) - (a[1]-o[1])*(b[0]-o[0])
+}
+
+// PolygonsIntersectSAT reports whether the convex polygons a and b overlap, using the
+// separating axis theorem: two convex polygons are disjoint if and only if there is an
+// edge normal of one of them along which their projections don't overlap. Polygons that
+// merely touch along an edge or at a vertex are considered to intersect.
+//
+// Both polygons must be convex, and may be given in either winding order. An empty
+// polygon intersects nothing.
+func PolygonsIntersectSAT(a, b []Vec2) bool {
+	_, ok := PolygonsMTV(a, b)
+	return ok
+}
+
+// PolygonsMTV returns the minimum translation vector of two overlapping convex polygons:
+// the shortest vector by which a must be moved so that it no longer overlaps b (beyond
+// touching). The boolean result is false if the polygons don't intersect, in which case
+// the vector is zero. Polygons that touch intersect with a zero-length MTV.
+//
+// The same requirements as PolygonsIntersectSAT apply.
+func PolygonsMTV(a, b []Vec2) (Vec2, bool) {
+	if len(a) == 0 || len(b) == 0 {
+		return Vec2{}, false
+	}
+
+	var mtv Vec2
+	depth := float32(-1)
+	for _, poly := range [2][]Vec2{a, b} {
+		for i, j := 0, len(poly)-1; i < len(poly); j, i = i, i+1 {
+			edge := poly[i].Sub(poly[j])
+			axis := Vec2{-edge[1], edge[0]}
+			l := axis.Len()
+			if l == 0 {
+				continue
+			}
+			axis = axis.Mul(1 / l)
+
+			minA, maxA := projectPolygon(a, axis)
+			minB, maxB := projectPolygon(b, axis)
+			if maxA < minB || maxB < minA {
+				return Vec2{}, false
+			}
+
+			// Push a out on whichever side needs the least movement
+			if overlap := maxB - minA; depth < 0 || overlap < depth {
+				depth, mtv = overlap, axis
+			}
+			if overlap := maxA - minB; overlap < depth {
+				depth, mtv = overlap, axis.Mul(-1)
+			}
+		}
+	}
+
+	if depth < 0 {
+		// Both polygons were single points; they intersect only if they coincide.
+		if a[0] == b[0] {
+			return Vec2{}, true
+		}
+		return Vec2{}, false
+	}
+
+	return mtv.Mul(depth), true
+}
+
+// projectPolygon returns the extent of the polygon along the axis.
+func projectPolygon(poly []Vec2, axis Vec2) (min, max float32) {
+	min = poly[0].Dot(axis)
+	max = min
+	for _, p := range poly[1:] {
+		d := p.Dot(axis)
+		if d < min {
+			min = d
+		} else if d > max {
+			max = d
+		}
+	}
+
+	return min, max
+}
+
+// PolygonSignedArea returns the signed area of the polygon, using the shoelace formula.
+// The area is positive if the vertices are in counterclockwise order and negative if
+// they are clockwise. Degenerate polygons, including those with fewer than 3 vertices,
+// have zero area.
+func PolygonSignedArea(points []Vec2) float32 {
+	if len(points) < 3 {
+		return 0
+	}
+
+	var area float32
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		area += points[j][0]*points[i][1] - points[i][0]*points[j][1]
+	}
+
+	return area / 2
+}
+
+// PolygonIsCCW reports whether the polygon's vertices are in counterclockwise order,
+// that is, whether its signed area is positive. Degenerate polygons are neither
+// clockwise nor counterclockwise, and return false.
+func PolygonIsCCW(points []Vec2) bool {
+	return PolygonSignedArea(points) > 0
+}
+
+// PolygonNormal returns the unit normal of a 3D polygon using Newell's method, which
+// sums contributions from every edge rather than crossing just two of them. This makes
+// it robust for concave polygons, polygons with collinear vertices, and polygons that
+// are slightly non-planar, for which it gives a best-fit normal. The normal points
+// towards the side from which the vertices appear in counterclockwise order.
+//
+// If the polygon has fewer than 3 vertices or no area, the zero vector is returned.
+func PolygonNormal(points []Vec3) Vec3 {
+	var n Vec3
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		a, b := points[j], points[i]
+		n[0] += (a[1] - b[1]) * (a[2] + b[2])
+		n[1] += (a[2] - b[2]) * (a[0] + b[0])
+		n[2] += (a[0] - b[0]) * (a[1] + b[1])
+	}
+
+	if l := n.Len(); l != 0 {
+		return n.Mul(1 / l)
+	}
+	return Vec3{}
+}
+
+// vec2Lexical sorts points by X, then by Y.
+type vec2Lexical []Vec2
+
+func (s vec2Lexical) Len() int      { return len(s) }
+func (s vec2Lexical) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s vec2Lexical) Less(i, j int) bool {
+	if s[i][0] != s[j][0] {
+		return s[i][0] < s[j][0]
+	}
+	return s[i][1] < s[j][1]
+}
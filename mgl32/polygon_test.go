@@ -0,0 +1,200 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestPointInPolygon(t *testing.T) {
+	square := []Vec2{{0, 0}, {2, 0}, {2, 2}, {0, 2}}
+	// A "U" shape, open at the top between x=1 and x=2
+	concave := []Vec2{{0, 0}, {3, 0}, {3, 3}, {2, 3}, {2, 1}, {1, 1}, {1, 3}, {0, 3}}
+	triangle := []Vec2{{0, 0}, {0, 4}, {4, 0}} // clockwise
+
+	tests := []struct {
+		Description string
+		Point       Vec2
+		Polygon     []Vec2
+		Expected    bool
+	}{
+		{"square center", Vec2{1, 1}, square, true},
+		{"square outside right", Vec2{3, 1}, square, false},
+		{"square outside below", Vec2{1, -0.5}, square, false},
+		{"square outside diagonal", Vec2{-1, -1}, square, false},
+		{"square on edge", Vec2{2, 1}, square, true},
+		{"square on bottom edge", Vec2{1, 0}, square, true},
+		{"square on vertex", Vec2{0, 0}, square, true},
+		{"square on far vertex", Vec2{2, 2}, square, true},
+		{"concave left arm", Vec2{0.5, 2}, concave, true},
+		{"concave right arm", Vec2{2.5, 2}, concave, true},
+		{"concave notch", Vec2{1.5, 2}, concave, false},
+		{"concave base", Vec2{1.5, 0.5}, concave, true},
+		{"concave notch edge", Vec2{1.5, 1}, concave, true},
+		{"concave ray through vertex", Vec2{-1, 1}, concave, false},
+		{"triangle inside", Vec2{1, 1}, triangle, true},
+		{"triangle hypotenuse", Vec2{2, 2}, triangle, true},
+		{"triangle outside", Vec2{3, 3}, triangle, false},
+		{"degenerate", Vec2{0, 0}, []Vec2{{0, 0}, {1, 1}}, false},
+		// Neither the point nor the edge's end are exactly representable
+		{"inexact edge below", Vec2{0.1, 0.1}, []Vec2{{0, 0}, {0.3, 0}, {0.3, 0.3}}, true},
+		{"inexact edge above", Vec2{0.1, 0.1}, []Vec2{{0, 0}, {0.3, 0.3}, {0, 0.3}}, true},
+		{"inexact edge long", Vec2{0.7, 2.1}, []Vec2{{0, 0}, {1.3, 3.9}, {0, 3.9}}, true},
+		{"inexact edge near miss", Vec2{0.1, 0.1001}, []Vec2{{0, 0}, {0.3, 0}, {0.3, 0.3}}, false},
+	}
+
+	for _, c := range tests {
+		if r := PointInPolygon(c.Point, c.Polygon); r != c.Expected {
+			t.Errorf("%v failed: PointInPolygon(%v, %v) != %v", c.Description, c.Point, c.Polygon, c.Expected)
+		}
+	}
+}
+
+func TestConvexHull2D(t *testing.T) {
+	tests := []struct {
+		Description string
+		Points      []Vec2
+		Expected    []Vec2
+	}{
+		{
+			"square with interior and edge points",
+			[]Vec2{{1, 1}, {0, 0}, {2, 2}, {0.5, 1.5}, {2, 0}, {1, 0}, {0, 2}, {2, 2}, {1.5, 0.25}},
+			[]Vec2{{0, 0}, {2, 0}, {2, 2}, {0, 2}},
+		},
+		{
+			"collinear",
+			[]Vec2{{2, 2}, {0, 0}, {1, 1}, {3, 3}, {1, 1}},
+			[]Vec2{{0, 0}, {3, 3}},
+		},
+		{
+			"duplicates",
+			[]Vec2{{1, 2}, {1, 2}, {1, 2}},
+			[]Vec2{{1, 2}},
+		},
+		{
+			"empty",
+			nil,
+			nil,
+		},
+	}
+
+	for _, c := range tests {
+		r := ConvexHull2D(c.Points)
+		if len(r) != len(c.Expected) {
+			t.Errorf("%v failed: ConvexHull2D(%v) != %v (got %v)", c.Description, c.Points, c.Expected, r)
+			continue
+		}
+		for i := range r {
+			if r[i] != c.Expected[i] {
+				t.Errorf("%v failed: ConvexHull2D(%v) != %v (got %v)", c.Description, c.Points, c.Expected, r)
+				break
+			}
+		}
+	}
+}
+
+func TestPolygonSignedArea(t *testing.T) {
+	tests := []struct {
+		Description string
+		Points      []Vec2
+		Area        float32
+		CCW         bool
+	}{
+		{"ccw square", []Vec2{{0, 0}, {2, 0}, {2, 2}, {0, 2}}, 4, true},
+		{"cw square", []Vec2{{0, 0}, {0, 2}, {2, 2}, {2, 0}}, -4, false},
+		{"degenerate", []Vec2{{0, 0}, {1, 1}, {2, 2}, {3, 3}}, 0, false},
+		{"too few points", []Vec2{{0, 0}, {1, 1}}, 0, false},
+	}
+
+	for _, c := range tests {
+		if r := PolygonSignedArea(c.Points); !FloatEqual(r, c.Area) {
+			t.Errorf("%v failed: PolygonSignedArea(%v) != %v (got %v)", c.Description, c.Points, c.Area, r)
+		}
+		if r := PolygonIsCCW(c.Points); r != c.CCW {
+			t.Errorf("%v failed: PolygonIsCCW(%v) != %v (got %v)", c.Description, c.Points, c.CCW, r)
+		}
+	}
+}
+
+func TestPolygonNormal(t *testing.T) {
+	tests := []struct {
+		Description string
+		Points      []Vec3
+		Expected    Vec3
+	}{
+		{"ccw quad", []Vec3{{0, 0, 1}, {2, 0, 1}, {2, 2, 1}, {0, 2, 1}}, Vec3{0, 0, 1}},
+		{"cw quad", []Vec3{{0, 0, 0}, {0, 0, 3}, {0, 1, 3}, {0, 1, 0}}, Vec3{-1, 0, 0}},
+		// The first three vertices are collinear, so crossing two edges would fail
+		{"concave with collinear start", []Vec3{{0, 0, 0}, {1, 0, 0}, {2, 0, 0}, {2, 2, 0}, {1, 1, 0}, {0, 2, 0}}, Vec3{0, 0, 1}},
+		{"degenerate", []Vec3{{0, 0, 0}, {1, 1, 1}, {2, 2, 2}}, Vec3{}},
+		{"too few points", []Vec3{{0, 0, 0}, {1, 0, 0}}, Vec3{}},
+	}
+
+	for _, c := range tests {
+		if r := PolygonNormal(c.Points); !r.EqualThreshold(c.Expected, 1e-6) {
+			t.Errorf("%v failed: PolygonNormal(%v) != %v (got %v)", c.Description, c.Points, c.Expected, r)
+		}
+	}
+
+	// Raising and lowering alternate corners warps a quad symmetrically, which leaves
+	// its best-fit normal unchanged; a small asymmetric warp only tilts it slightly.
+	warped := []Vec3{{0, 0, 0.05}, {1, 0, -0.05}, {1, 1, 0.05}, {0, 1, -0.05}}
+	r := PolygonNormal(warped)
+	if !r.EqualThreshold(Vec3{0, 0, 1}, 1e-6) {
+		t.Errorf("warped quad failed: PolygonNormal(%v) != %v (got %v)", warped, Vec3{0, 0, 1}, r)
+	}
+
+	warped = []Vec3{{0, 0, 0}, {1, 0, 0.1}, {1, 1, 0.1}, {0, 1, 0.02}}
+	if r := PolygonNormal(warped); !FloatEqualThreshold(r.Len(), 1, 1e-6) || r[2] < 0.99 {
+		t.Errorf("warped quad failed: PolygonNormal(%v) is not close to +Z (got %v)", warped, r)
+	}
+}
+
+func TestPolygonsSAT(t *testing.T) {
+	square := func(x, y, size float32) []Vec2 {
+		return []Vec2{{x, y}, {x + size, y}, {x + size, y + size}, {x, y + size}}
+	}
+	diamond := []Vec2{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+
+	tests := []struct {
+		A, B      []Vec2
+		Intersect bool
+		MTV       Vec2
+	}{
+		{square(0, 0, 2), square(1.5, 0.5, 2), true, Vec2{-0.5, 0}},
+		{square(0, 0, 2), square(0.5, -1.75, 2), true, Vec2{0, 0.25}},
+		{square(0, 0, 2), square(3, 0, 2), false, Vec2{}},
+		{square(0, 0, 2), square(2.5, 2.5, 1), false, Vec2{}},
+		{square(0, 0, 2), square(2, 0, 2), true, Vec2{}},
+		{square(0, 0, 2), square(2, 2, 2), true, Vec2{}},
+		{square(0, 0, 1), square(-1, -0.5, 4), true, Vec2{0, -1.5}},
+		{diamond, square(0.6, 0.6, 1), false, Vec2{}},
+		{diamond, square(0.5, 0.5, 1), true, Vec2{}},
+		{diamond, square(0.25, 0.25, 1), true, Vec2{-0.25, -0.25}},
+		{nil, square(0, 0, 1), false, Vec2{}},
+	}
+
+	for _, c := range tests {
+		if r := PolygonsIntersectSAT(c.A, c.B); r != c.Intersect {
+			t.Errorf("PolygonsIntersectSAT(%v, %v) != %v", c.A, c.B, c.Intersect)
+		}
+
+		mtv, ok := PolygonsMTV(c.A, c.B)
+		if ok != c.Intersect || !mtv.EqualThreshold(c.MTV, 1e-5) {
+			t.Errorf("PolygonsMTV(%v, %v) != %v, %v (got %v, %v)", c.A, c.B, c.MTV, c.Intersect, mtv, ok)
+		}
+
+		// Moving a by the MTV leaves the polygons just touching
+		if ok {
+			moved := make([]Vec2, len(c.A))
+			for i, p := range c.A {
+				moved[i] = p.Add(mtv)
+			}
+			if r, _ := PolygonsMTV(moved, c.B); !r.EqualThreshold(Vec2{}, 1e-5) {
+				t.Errorf("PolygonsMTV(%v, %v) after resolving != %v (got %v)", moved, c.B, Vec2{}, r)
+			}
+		}
+	}
+}
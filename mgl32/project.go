@@ -9,6 +9,9 @@ import (
 	"math"
 )
 
+// Ortho generates a right-handed orthographic projection matrix, following the
+// OpenGL (glOrtho) convention: the camera looks down -Z, and near and far are
+// distances along that direction. See OrthoLH for the left-handed variant.
 func Ortho(left, right, bottom, top, near, far float32) Mat4 {
 	rml, tmb, fmn := (right - left), (top - bottom), (far - near)
 
@@ -20,6 +23,16 @@ func Ortho2D(left, right, bottom, top float32) Mat4 {
 	return Ortho(left, right, bottom, top, -1, 1)
 }
 
+// OrthoCentered generates a symmetric orthographic projection matrix for a box of the
+// given width and height centered on the view axis. It is equivalent to
+// Ortho(-width/2, width/2, -height/2, height/2, near, far).
+func OrthoCentered(width, height, near, far float32) Mat4 {
+	return Ortho(-width/2, width/2, -height/2, height/2, near, far)
+}
+
+// Perspective generates a right-handed perspective projection matrix, following the
+// OpenGL (gluPerspective) convention: the camera looks down -Z and fovy is the
+// vertical field of view in radians. See PerspectiveLH for the left-handed variant.
 func Perspective(fovy, aspect, near, far float32) Mat4 {
 	// fovy = (fovy * math.Pi) / 180.0 // convert from degrees to radians
 	nmf, f := near-far, float32(1./math.Tan(float64(fovy)/2.0))
@@ -27,6 +40,56 @@ func Perspective(fovy, aspect, near, far float32) Mat4 {
 	return Mat4{float32(f / aspect), 0, 0, 0, 0, float32(f), 0, 0, 0, 0, float32((near + far) / nmf), -1, 0, 0, float32((2. * far * near) / nmf), 0}
 }
 
+// OrthoLH generates a left-handed orthographic projection matrix, as used by Direct3D:
+// the camera looks down +Z, and near and far are distances along that direction.
+// It is equivalent to Ortho with the Z axis flipped.
+//
+// Depth is still mapped to OpenGL's [-1, 1] clip range rather than Direct3D's [0, 1],
+// so the result can be used with the rest of this package (e.g. Project).
+func OrthoLH(left, right, bottom, top, near, far float32) Mat4 {
+	m := Ortho(left, right, bottom, top, near, far)
+	m[10] = -m[10]
+	return m
+}
+
+// PerspectiveLH generates a left-handed perspective projection matrix, as used by
+// Direct3D: the camera looks down +Z and fovy is the vertical field of view in radians.
+// It is equivalent to Perspective with the Z axis flipped.
+//
+// Depth is still mapped to OpenGL's [-1, 1] clip range rather than Direct3D's [0, 1],
+// so the result can be used with the rest of this package (e.g. Project).
+func PerspectiveLH(fovy, aspect, near, far float32) Mat4 {
+	m := Perspective(fovy, aspect, near, far)
+	m[8], m[9], m[10], m[11] = -m[8], -m[9], -m[10], -m[11]
+	return m
+}
+
+// PerspectiveParams recovers the parameters of a perspective projection matrix such as
+// one built by Perspective. The vertical field of view is returned in radians.
+//
+// If the matrix does not have the structure of a symmetric perspective projection
+// (non-zero off-center or skew terms, a w row other than (0, 0, -1, 0), or degenerate
+// near and far planes), ok is false and the other values are meaningless.
+func (m Mat4) PerspectiveParams() (fovy, aspect, near, far float32, ok bool) {
+	const tolerance = 1e-6
+	for _, i := range [...]int{1, 2, 3, 4, 6, 7, 8, 9, 12, 13, 15} {
+		if Abs(m[i]) > tolerance {
+			return 0, 0, 0, 0, false
+		}
+	}
+
+	if Abs(m[11]+1) > tolerance || m[0] == 0 || m[5] == 0 || m[10] == 1 || m[10] == -1 {
+		return 0, 0, 0, 0, false
+	}
+
+	fovy = float32(2 * math.Atan(float64(1/m[5])))
+	aspect = m[5] / m[0]
+	near = m[14] / (m[10] - 1)
+	far = m[14] / (m[10] + 1)
+
+	return fovy, aspect, near, far, true
+}
+
 func Frustum(left, right, bottom, top, near, far float32) Mat4 {
 	rml, tmb, fmn := (right - left), (top - bottom), (far - near)
 	A, B, C, D := (right+left)/rml, (top+bottom)/tmb, -(far+near)/fmn, -(2*far*near)/fmn
@@ -54,6 +117,121 @@ func LookAtV(eye, center, up Vec3) Mat4 {
 	return M.Mul4(Translate3D(float32(-eye[0]), float32(-eye[1]), float32(-eye[2])))
 }
 
+// LookAtLH generates a left-handed view matrix, as used by Direct3D (D3DXMatrixLookAtLH),
+// mapping the direction from eye to center onto +Z in eye space, with +X to the right
+// and +Y up. LookAtV is the right-handed equivalent, which maps it onto -Z.
+// The two should not be mixed with the other's projection matrices.
+func LookAtLH(eye, center, up Vec3) Mat4 {
+	f := center.Sub(eye).Normalize()
+	s := up.Normalize().Cross(f).Normalize()
+	u := f.Cross(s)
+
+	M := Mat4{
+		s[0], u[0], f[0], 0,
+		s[1], u[1], f[1], 0,
+		s[2], u[2], f[2], 0,
+		0, 0, 0, 1,
+	}
+
+	return M.Mul4(Translate3D(-eye[0], -eye[1], -eye[2]))
+}
+
+// CubeMapView generates the view matrix for rendering one face of a cube map from the
+// given position. Faces are numbered in the OpenGL order +X, -X, +Y, -Y, +Z, -Z
+// (0 through 5), so face can be used as an offset from GL_TEXTURE_CUBE_MAP_POSITIVE_X.
+// The up vectors follow the OpenGL cube map convention, which is upside down relative
+// to LookAtV's usual use for all but the Y faces.
+//
+// The matrix is meant to be combined with a 90 degree, aspect 1 projection.
+// CubeMapView panics if face is not in the range [0,5].
+func CubeMapView(face int, position Vec3) Mat4 {
+	var forward, up Vec3
+	switch face {
+	case 0:
+		forward, up = Vec3{1, 0, 0}, Vec3{0, -1, 0}
+	case 1:
+		forward, up = Vec3{-1, 0, 0}, Vec3{0, -1, 0}
+	case 2:
+		forward, up = Vec3{0, 1, 0}, Vec3{0, 0, 1}
+	case 3:
+		forward, up = Vec3{0, -1, 0}, Vec3{0, 0, -1}
+	case 4:
+		forward, up = Vec3{0, 0, 1}, Vec3{0, -1, 0}
+	case 5:
+		forward, up = Vec3{0, 0, -1}, Vec3{0, -1, 0}
+	default:
+		panic("CubeMapView: face out of range [0,5]")
+	}
+
+	return LookAtV(position, position.Add(forward), up)
+}
+
+// ShadowMatrix generates a matrix that flattens geometry onto a plane, as seen from a light.
+// This is the classic planar shadow projection.
+//
+// The plane is given as (a, b, c, d) such that ax + by + cz + d = 0 for every point on it.
+// The light is given in homogeneous coordinates: a point light has w=1 and is
+// located at (x, y, z), while a directional light has w=0 and (x, y, z) is the direction
+// pointing towards the light.
+//
+// The result is non-affine, so transformed points must be divided by w (e.g. with TransformCoordinate).
+func ShadowMatrix(plane Vec4, lightPos Vec4) Mat4 {
+	d := plane.Dot(lightPos)
+
+	var m Mat4
+	for col := 0; col < 4; col++ {
+		for row := 0; row < 4; row++ {
+			m[col*4+row] = -lightPos[row] * plane[col]
+		}
+		m[col*4+col] += d
+	}
+
+	return m
+}
+
+// ViewportMatrix generates the transform from normalized device coordinates to window
+// coordinates for a viewport with its origin at (x, y) and the given width and height.
+// NDC (-1, -1) maps to (x, y) and (1, 1) maps to (x+width, y+height). Depth is mapped
+// from [-1, 1] to [0, 1], matching the default glDepthRange and Project.
+func ViewportMatrix(x, y, width, height float32) Mat4 {
+	return Mat4{
+		width / 2, 0, 0, 0,
+		0, height / 2, 0, 0,
+		0, 0, 0.5, 0,
+		x + width/2, y + height/2, 0.5, 1,
+	}
+}
+
+// SnapToTexelGrid rounds the X and Y extents of an orthographic light volume, given
+// in light space by its min and max corners, to whole texels of a shadow map with the
+// given resolution. Without this, the bounds of a cascaded shadow map shift by
+// fractions of a texel as the camera moves, which makes shadow edges shimmer.
+//
+// The size of the volume is kept, and the min corner is moved down to the nearest
+// multiple of the texel size, so the volume only ever moves in whole-texel steps.
+// Z is left untouched since depth doesn't affect which texel a point falls in.
+//
+// If resolution is not positive there is no texel grid, and min and max are returned
+// unchanged. The same goes for an axis along which the volume is empty.
+func SnapToTexelGrid(min, max Vec3, resolution int) (Vec3, Vec3) {
+	if resolution <= 0 {
+		return min, max
+	}
+
+	for i := 0; i < 2; i++ {
+		size := max[i] - min[i]
+		texel := size / float32(resolution)
+		if texel <= 0 {
+			continue
+		}
+
+		min[i] = float32(math.Floor(float64(min[i]/texel))) * texel
+		max[i] = min[i] + size
+	}
+
+	return min, max
+}
+
 // Transform a set of coordinates from object space (in obj) to window coordinates (with depth)
 //
 // Window coordinates are continuous, not discrete (well, as continuous as an IEEE Floating Point can be), so you won't get exact pixel locations
@@ -5,6 +5,7 @@
 package mgl32
 
 import (
+	"errors"
 	"math"
 )
 
@@ -100,6 +101,9 @@ func (q1 Quat) Scale(c float32) Quat {
 
 // Returns the conjugate of a quaternion. Equivalent to
 // Quat{q1.W, q1.V.Mul(-1)}
+//
+// For a unit quaternion the conjugate is also its inverse, and it's cheaper to
+// compute. For a quaternion of any other length, use Inverse instead.
 func (q1 Quat) Conjugate() Quat {
 	return Quat{q1.W, q1.V.Mul(-1)}
 }
@@ -140,6 +144,9 @@ func (q1 Quat) Normalize() Quat {
 // This method computes the square norm by directly adding the sum
 // of the squares of all terms instead of actually squaring q1.Len(),
 // both for performance and precision.
+//
+// Unlike the conjugate, q1.Mul(q1.Inverse()) is the identity even if q1 is not
+// a unit quaternion.
 func (q1 Quat) Inverse() Quat {
 	return q1.Conjugate().Scale(1 / q1.Dot(q1))
 }
@@ -159,6 +166,26 @@ func (q1 Quat) Rotate(v Vec3) Vec3 {
 	return v.Add(cross.Mul(2 * q1.W)).Add(q1.V.Mul(2).Cross(cross))
 }
 
+// RotateVec3 rotates a vector by the rotation this quaternion represents,
+// giving the same result as Rotate. It uses the formulation
+//
+//	t = 2 * (q_v x v)
+//	v' = v + q_w * t + q_v x t
+//
+// which needs two cross products and no full quaternion multiplication.
+// As with Rotate, the quaternion is assumed to be normalized.
+func (q1 Quat) RotateVec3(v Vec3) Vec3 {
+	tx := 2 * (q1.V[1]*v[2] - q1.V[2]*v[1])
+	ty := 2 * (q1.V[2]*v[0] - q1.V[0]*v[2])
+	tz := 2 * (q1.V[0]*v[1] - q1.V[1]*v[0])
+
+	return Vec3{
+		v[0] + q1.W*tx + q1.V[1]*tz - q1.V[2]*ty,
+		v[1] + q1.W*ty + q1.V[2]*tx - q1.V[0]*tz,
+		v[2] + q1.W*tz + q1.V[0]*ty - q1.V[1]*tx,
+	}
+}
+
 // Returns the homogeneous 3D rotation matrix corresponding to the quaternion.
 func (q1 Quat) Mat4() Mat4 {
 	w, x, y, z := q1.W, q1.V[0], q1.V[1], q1.V[2]
@@ -206,6 +233,26 @@ func (q1 Quat) OrientationEqualThreshold(q2 Quat, epsilon float32) bool {
 	return Abs(q1.Normalize().Dot(q2.Normalize())) > 1-epsilon
 }
 
+// QuatAngleBetween returns the angle, in radians and in [0, Pi], of the rotation
+// that takes orientation a to orientation b.
+//
+// Since q and -q represent the same orientation, the shorter of the two possible
+// rotations is always chosen (equivalently, the absolute value of the dot product
+// is used). The angle is computed with Atan2 rather than Acos so that it stays
+// accurate for nearly identical orientations.
+func QuatAngleBetween(a, b Quat) float32 {
+	d := b.Normalize().Mul(a.Normalize().Conjugate())
+	return 2 * float32(math.Atan2(float64(d.V.Len()), math.Abs(float64(d.W))))
+}
+
+// QuatAngularErrorDeg returns the angle, in degrees and in [0, 180], of the rotation
+// needed to align orientation a with orientation b. It is QuatAngleBetween in degrees,
+// which reads more naturally when checking that two orientation pipelines agree to
+// within some tolerance; q and -q are considered the same orientation.
+func QuatAngularErrorDeg(a, b Quat) float32 {
+	return RadToDeg(QuatAngleBetween(a, b))
+}
+
 // Slerp is *S*pherical *L*inear Int*erp*olation, a method of interpolating
 // between two quaternions. This always takes the straightest path on the sphere between
 // the two quaternions, and maintains constant velocity.
@@ -230,6 +277,54 @@ func QuatSlerp(q1, q2 Quat, amount float32) Quat {
 	return q1.Scale(c).Add(rel.Scale(s))
 }
 
+// QuatSlerpN returns n rotations evenly spaced by angle along the Slerp path from q1
+// to q2, including both ends, as when generating intermediate keyframes. Element i is
+// QuatSlerp(q1, q2, i/(n-1)), but the angle between the inputs and its sine are only
+// computed once. Like QuatSlerp, the inputs are normalized, and the path is not
+// flipped to the shorter arc if q1.Dot(q2) is negative.
+//
+// The exception is when q2 is (nearly) -q1: the path between them is then a half
+// turn in 4D whose direction is undefined, although both represent (almost) the same
+// orientation. In that case q2 is flipped, and the intermediate rotations lie on the
+// short path between the two orientations. The last element is still q2.
+//
+// If n is 1 the result holds just q1; if n is less than 1 it is nil.
+func QuatSlerpN(q1, q2 Quat, n int) []Quat {
+	if n < 1 {
+		return nil
+	}
+
+	q1, q2 = q1.Normalize(), q2.Normalize()
+	quats := make([]Quat, n)
+	quats[0] = q1
+	if n == 1 {
+		return quats
+	}
+	quats[n-1] = q2
+
+	dot := q1.Dot(q2)
+	if dot < -0.9995 {
+		q2, dot = q2.Scale(-1), -dot
+	}
+
+	theta := math.Acos(float64(Clamp(dot, -1, 1)))
+	sinTheta := math.Sin(theta)
+	for i := 1; i < n-1; i++ {
+		t := float64(i) / float64(n-1)
+		if dot > 0.9995 {
+			// Too close for comfort, see QuatSlerp
+			quats[i] = QuatNlerp(q1, q2, float32(t))
+			continue
+		}
+
+		a := float32(math.Sin((1-t)*theta) / sinTheta)
+		b := float32(math.Sin(t*theta) / sinTheta)
+		quats[i] = q1.Scale(a).Add(q2.Scale(b))
+	}
+
+	return quats
+}
+
 // *L*inear Int*erp*olation between two Quaternions, cheap and simple.
 //
 // Not excessively useful, but uses can be found.
@@ -248,6 +343,55 @@ func QuatNlerp(q1, q2 Quat, amount float32) Quat {
 	return QuatLerp(q1, q2, amount).Normalize()
 }
 
+// QuatWeightedBlend computes a weighted average of several rotations, e.g. to blend
+// the poses of multiple animation clips. Each quaternion is first flipped, if needed,
+// onto the same hemisphere as quats[0] so that every one contributes along the
+// shortest path, then the weighted sum is normalized. Weights need not sum to 1.
+//
+// This is the normalized-linear generalization of QuatNlerp: for two rotations with
+// weights 1-t and t it gives the same result as QuatNlerp. It is a good
+// approximation of the true geodesic mean when the rotations are reasonably close to
+// each other, which is the usual case when blending poses.
+//
+// An error is returned if the slices are empty or have different lengths, or if the
+// weighted sum is zero (for instance when all weights are zero).
+func QuatWeightedBlend(quats []Quat, weights []float32) (Quat, error) {
+	if len(quats) != len(weights) {
+		return Quat{}, errors.New("quats and weights must have the same length")
+	}
+	if len(quats) == 0 {
+		return Quat{}, errors.New("cannot blend an empty set of quaternions")
+	}
+
+	ref := quats[0]
+	var sum Quat
+	for i, q := range quats {
+		if ref.Dot(q) < 0 {
+			q = q.Scale(-1)
+		}
+		sum = sum.Add(q.Scale(weights[i]))
+	}
+
+	if sum.Len() == 0 {
+		return Quat{}, errors.New("weighted sum of quaternions is zero")
+	}
+
+	return sum.Normalize(), nil
+}
+
+// QuatIntegrate advances the orientation q by the angular velocity (in radians per unit
+// time, expressed in world space) over a time step dt, as a physics or IMU integrator
+// would. It applies the first-order update q + dt/2 * (0, angularVelocity) * q and
+// renormalizes, which keeps the result a valid rotation at any step size.
+//
+// The rotation applied in one step is slightly less than |angularVelocity|*dt, with an
+// error proportional to its cube, so the step should be small relative to the rate of
+// rotation.
+func QuatIntegrate(q Quat, angularVelocity Vec3, dt float32) Quat {
+	spin := Quat{0, angularVelocity}.Mul(q)
+	return q.Add(spin.Scale(dt / 2)).Normalize()
+}
+
 // Performs a rotation in the specified order. If the order is not
 // a valid RotationOrder, this function will panic
 //
@@ -345,6 +489,40 @@ func AnglesToQuat(angle1, angle2, angle3 float32, order RotationOrder) Quat {
 	return ret
 }
 
+// QuatFromEuler creates a rotation from Euler angles given per axis: angles[0] is the
+// rotation about the X axis, angles[1] about Y and angles[2] about Z, regardless of the
+// order. The order must be one of the six Tait-Bryan orders (XYZ, XZY, YXZ, YZX, ZXY,
+// ZYX), otherwise this function will panic.
+//
+// The order names the quaternion product. For instance, for XYZ the result is
+//
+//	QuatRotate(angles[0], X).Mul(QuatRotate(angles[1], Y)).Mul(QuatRotate(angles[2], Z))
+//
+// which, when rotating a vector, applies the Z rotation first, then Y, then X, all about
+// the fixed world axes. Equivalently, it rotates about X first, then about the rotated
+// Y axis, then about the twice-rotated Z axis. This is the same convention as
+// AnglesToQuat, which takes the angles in the order they are applied instead.
+func QuatFromEuler(angles Vec3, order RotationOrder) Quat {
+	x, y, z := angles.Elem()
+
+	switch order {
+	case XYZ:
+		return AnglesToQuat(x, y, z, order)
+	case XZY:
+		return AnglesToQuat(x, z, y, order)
+	case YXZ:
+		return AnglesToQuat(y, x, z, order)
+	case YZX:
+		return AnglesToQuat(y, z, x, order)
+	case ZXY:
+		return AnglesToQuat(z, x, y, order)
+	case ZYX:
+		return AnglesToQuat(z, y, x, order)
+	default:
+		panic("QuatFromEuler requires a Tait-Bryan rotation order")
+	}
+}
+
 // Mat4ToQuat converts a pure rotation matrix into a quaternion
 func Mat4ToQuat(m Mat4) Quat {
 	// http://www.euclideanspace.com/maths/geometry/rotations/conversions/matrixToQuaternion/index.htm
@@ -397,6 +575,66 @@ func Mat4ToQuat(m Mat4) Quat {
 	}
 }
 
+// AlignFrames returns the rotation that carries the orthonormal frame from onto the
+// frame to, where each frame is given as a matrix whose columns are its basis vectors.
+// That is, the result rotates from's first column onto to's first column, and so on.
+//
+// The rotation is to * fromᵀ, converted to a quaternion. Both frames must be
+// orthonormal and of the same handedness for the result to be meaningful.
+func AlignFrames(from, to Mat3) Quat {
+	return Mat4ToQuat(to.Mul3(from.Transpose()).Mat4()).Normalize()
+}
+
+// QuatFromDirection creates a rotation that turns an object's forward axis to point
+// along direction, keeping its up axis as close to up as possible. As with
+// QuatLookAtV, the front of the object is assumed to be Z- and its up Y+, but this
+// is the object's own rotation rather than the (inverse) rotation of a camera.
+//
+// If direction is parallel to up, there's no unique answer and an arbitrary up
+// perpendicular to direction is used instead.
+func QuatFromDirection(direction, up Vec3) Quat {
+	f := direction.Normalize()
+	r := f.Cross(up)
+	if r.Len() <= 1e-4*up.Len() || r.Len() <= Epsilon {
+		r = perpendicularTo(f)
+	} else {
+		r = r.Normalize()
+	}
+	u := r.Cross(f)
+
+	return Mat4ToQuat(Mat3FromCols(r, u, f.Mul(-1)).Mat4()).Normalize()
+}
+
+// YawOnlyLookAt creates a rotation about worldUp only that turns an object at from to
+// face to, as needed by a top-down camera or a turret that must not tilt. The direction
+// to the target is projected onto the plane perpendicular to worldUp before the angle
+// is computed, so the result never contains any pitch or roll. As with
+// QuatFromDirection, the front of the object is assumed to be Z-.
+//
+// If from and to are aligned along worldUp there is no horizontal direction to face,
+// and the identity rotation is returned.
+func YawOnlyLookAt(from, to Vec3, worldUp Vec3) Quat {
+	up := worldUp.Normalize()
+	flatten := func(v Vec3) Vec3 {
+		return v.Sub(up.Mul(v.Dot(up)))
+	}
+
+	dir := flatten(to.Sub(from))
+	if dir.Len() <= 1e-6*to.Sub(from).Len() {
+		return QuatIdent()
+	}
+
+	// The object's forward axis, as seen from above. If up is along Z, the
+	// forward axis is vertical, so Y+ is used as the reference instead.
+	forward := flatten(Vec3{0, 0, -1})
+	if forward.Len() <= 1e-6 {
+		forward = flatten(Vec3{0, 1, 0})
+	}
+
+	angle := math.Atan2(float64(up.Dot(forward.Cross(dir))), float64(forward.Dot(dir)))
+	return QuatRotate(float32(angle), up)
+}
+
 // QuatLookAtV creates a rotation from an eye vector to a center vector
 //
 // It assumes the front of the rotated object at Z- and up at Y+
@@ -0,0 +1,58 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+)
+
+// These generators produce random, well-conditioned inputs for property-based
+// tests. They take an explicit *rand.Rand so that tests can be made repeatable
+// by seeding it.
+
+// RandomVec3 returns a vector whose elements are uniformly distributed in [-scale, scale).
+func RandomVec3(r *rand.Rand, scale float32) Vec3 {
+	return Vec3{
+		(2*float32(r.Float64()) - 1) * scale,
+		(2*float32(r.Float64()) - 1) * scale,
+		(2*float32(r.Float64()) - 1) * scale,
+	}
+}
+
+// RandomRotationMat4 returns a rotation matrix drawn uniformly from all 3D
+// rotations, using Shoemake's method for generating uniform random unit quaternions.
+func RandomRotationMat4(r *rand.Rand) Mat4 {
+	u1, u2, u3 := r.Float64(), 2*math.Pi*r.Float64(), 2*math.Pi*r.Float64()
+	a, b := math.Sqrt(1-u1), math.Sqrt(u1)
+
+	q := Quat{
+		float32(b * math.Cos(u3)),
+		Vec3{
+			float32(a * math.Sin(u2)),
+			float32(a * math.Cos(u2)),
+			float32(b * math.Sin(u3)),
+		},
+	}
+
+	return q.Normalize().Mat4()
+}
+
+// RandomAffineMat4 returns a random translation * rotation * scale matrix. The
+// translation is in [-10, 10) along each axis and the scale factors have magnitudes
+// in [0.5, 2), so the result is always comfortably invertible. Each scale factor
+// is negative with probability 1/2, so the result may contain reflections.
+func RandomAffineMat4(r *rand.Rand) Mat4 {
+	var scale Vec3
+	for i := range scale {
+		scale[i] = 0.5 + 1.5*float32(r.Float64())
+		if r.Intn(2) == 0 {
+			scale[i] = -scale[i]
+		}
+	}
+
+	t := RandomVec3(r, 10)
+	return Translate3D(t[0], t[1], t[2]).Mul4(RandomRotationMat4(r)).Mul4(Scale3D(scale[0], scale[1], scale[2]))
+}
@@ -0,0 +1,51 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRandomGenerators(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		v := RandomVec3(r, 3)
+		if v.LenChebyshev() >= 3 {
+			t.Errorf("RandomVec3(r, 3) out of range (got %v)", v)
+		}
+
+		rot := RandomRotationMat4(r).Mat3()
+		if !rot.Transpose().Mul3(rot).ApproxFuncEqual(Ident3(), absEqual(1e-5)) || !FloatEqualThreshold(rot.Det(), 1, 1e-5) {
+			t.Errorf("RandomRotationMat4 is not a rotation (got %v)", rot)
+		}
+
+		m := RandomAffineMat4(r)
+		if m.Row(3) != (Vec4{0, 0, 0, 1}) {
+			t.Errorf("RandomAffineMat4 is not affine (got %v)", m)
+		}
+		if d := Abs(m.Det()); d < 0.125 || d > 8 {
+			t.Errorf("RandomAffineMat4 has determinant %v outside the expected range", m.Det())
+		}
+	}
+}
+
+func TestInvInvProperty(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	for i := 0; i < 100; i++ {
+		m := RandomAffineMat4(r)
+		if inv := m.Inv().Inv(); !inv.ApproxFuncEqual(m, absEqual(1e-3)) {
+			t.Errorf("Inv(Inv(%v)) != itself (got %v)", m, inv)
+		}
+
+		// Inverting undoes the transform
+		p := RandomVec3(r, 100)
+		if q := TransformCoordinate(TransformCoordinate(p, m), m.Inv()); !q.EqualThreshold(p, 1e-2) {
+			t.Errorf("Inv(%v) did not undo the transform of %v (got %v)", m, p, q)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Ray is a half-line starting at Origin and extending along Direction. Most functions
+// expect Direction to be normalized, so that distances along the ray are in the same
+// units as the space it's in.
+type Ray struct {
+	Origin, Direction Vec3
+}
+
+// TransformRay transforms r by m, for instance to move a world space picking ray
+// into an object's local space using the inverse of the object's model matrix.
+// The origin is transformed as a point (as with TransformCoordinate) and the
+// direction as a direction (as with TransformNormal), then re-normalized.
+//
+// Because the direction is re-normalized, distances along the transformed ray are
+// measured in the new space's units. If m scales, they won't match distances
+// along the original ray.
+func (m Mat4) TransformRay(r Ray) Ray {
+	return Ray{
+		Origin:    TransformCoordinate(r.Origin, m),
+		Direction: TransformNormal(r.Direction, m).Normalize(),
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+// rayUnitSphere returns the distance along r to the first intersection with the
+// unit sphere at the origin.
+func rayUnitSphere(r Ray) (float32, bool) {
+	b := r.Origin.Dot(r.Direction)
+	c := r.Origin.Dot(r.Origin) - 1
+	disc := b*b - c
+	if disc < 0 {
+		return 0, false
+	}
+
+	return -b - float32(math.Sqrt(float64(disc))), true
+}
+
+func TestTransformRay(t *testing.T) {
+	// A sphere of radius 2 centered at (5, 0, 0)
+	model := Translate3D(5, 0, 0).Mul4(Scale3D(2, 2, 2))
+	toLocal := model.Inv()
+
+	tests := []struct {
+		Description string
+		World       Ray
+		Hit         bool
+		Point       Vec3
+	}{
+		{"hit head on", Ray{Vec3{0, 0, 0}, Vec3{1, 0, 0}}, true, Vec3{3, 0, 0}},
+		{"hit from above", Ray{Vec3{5, 10, 0}, Vec3{0, -1, 0}}, true, Vec3{5, 2, 0}},
+		{"miss", Ray{Vec3{0, 3, 0}, Vec3{1, 0, 0}}, false, Vec3{}},
+	}
+
+	for _, c := range tests {
+		local := toLocal.TransformRay(c.World)
+		if !FloatEqual(local.Direction.Len(), 1) {
+			t.Errorf("%v failed: TransformRay direction is not normalized (got %v)", c.Description, local.Direction)
+		}
+
+		dist, hit := rayUnitSphere(local)
+		if hit != c.Hit {
+			t.Errorf("%v failed: expected hit %v (got %v)", c.Description, c.Hit, hit)
+			continue
+		}
+		if !hit {
+			continue
+		}
+
+		p := TransformCoordinate(local.Origin.Add(local.Direction.Mul(dist)), model)
+		if !p.EqualThreshold(c.Point, 1e-4) {
+			t.Errorf("%v failed: expected hit at %v (got %v)", c.Description, c.Point, p)
+		}
+	}
+}
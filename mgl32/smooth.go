@@ -0,0 +1,179 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// A Vec3Smoother filters a stream of noisy vectors, such as tracked positions,
+// returning a smoothed value for each new sample. It either applies exponential
+// smoothing, where each output moves a fixed fraction alpha of the way toward the
+// newest sample, or averages the most recent samples over a fixed window.
+//
+// Create one with NewVec3Smoother or NewVec3WindowSmoother. A Vec3Smoother is not
+// safe for concurrent use.
+type Vec3Smoother struct {
+	alpha float32
+
+	// For the windowed average: window is a ring buffer of the last samples,
+	// next is where the next sample goes, and count is how many are filled in.
+	window      []Vec3
+	next, count int
+
+	value   Vec3
+	started bool
+}
+
+// NewVec3Smoother returns a smoother using exponential smoothing: each output is
+// prev + alpha*(v - prev). alpha must be in (0,1]; smaller values smooth more but
+// lag further behind, and 1 disables smoothing. The first sample is passed through
+// unchanged.
+func NewVec3Smoother(alpha float32) *Vec3Smoother {
+	if alpha <= 0 || alpha > 1 {
+		panic("Vec3Smoother alpha must be in the range (0,1]")
+	}
+
+	return &Vec3Smoother{alpha: alpha}
+}
+
+// NewVec3WindowSmoother returns a smoother that outputs the unweighted mean of the
+// last size samples (or of all samples so far, until size have been pushed). size
+// must be at least 1.
+func NewVec3WindowSmoother(size int) *Vec3Smoother {
+	if size < 1 {
+		panic("Vec3Smoother window size must be at least 1")
+	}
+
+	return &Vec3Smoother{window: make([]Vec3, size)}
+}
+
+// Push adds a sample to the stream and returns the new smoothed value.
+func (s *Vec3Smoother) Push(v Vec3) Vec3 {
+	if s.window != nil {
+		s.window[s.next] = v
+		s.next = (s.next + 1) % len(s.window)
+		if s.count < len(s.window) {
+			s.count++
+		}
+
+		// Summing the window each time, rather than keeping a running sum, avoids
+		// accumulating rounding error over long streams.
+		var sum Vec3
+		for _, w := range s.window[:s.count] {
+			sum = sum.Add(w)
+		}
+		s.value = sum.Mul(1 / float32(s.count))
+	} else if !s.started {
+		s.value = v
+	} else {
+		s.value = s.value.Add(v.Sub(s.value).Mul(s.alpha))
+	}
+
+	s.started = true
+	return s.value
+}
+
+// Value returns the most recent smoothed value, or the zero vector if nothing has
+// been pushed yet.
+func (s *Vec3Smoother) Value() Vec3 {
+	return s.value
+}
+
+// Reset discards all samples, returning the smoother to its initial state.
+func (s *Vec3Smoother) Reset() {
+	s.value, s.started = Vec3{}, false
+	s.next, s.count = 0, 0
+}
+
+// smoothDampDecay returns the omega and decay factor of a critically damped spring
+// with the given smooth time over a step of dt, using the polynomial approximation
+// of exp(-omega*dt) from Game Programming Gems 4, section 1.10.
+func smoothDampDecay(smoothTime, dt float32) (omega, decay float32) {
+	if smoothTime < 1e-4 {
+		smoothTime = 1e-4
+	}
+
+	omega = 2 / smoothTime
+	x := omega * dt
+	return omega, 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+}
+
+// SmoothDamp moves current toward target as a critically damped spring, which reaches
+// the target as fast as possible without oscillating. This is the usual way to make a
+// camera follow an object smoothly, and behaves like Unity's SmoothDamp without a
+// maximum speed.
+//
+// smoothTime is roughly the time it takes to reach the target, and dt is the time
+// step. The spring's velocity is kept in *velocity, which must be preserved between
+// calls (start it at zero) and is updated on return. The result never overshoots the
+// target, even when the target or velocity change abruptly.
+func SmoothDamp(current, target Vec3, velocity *Vec3, smoothTime, dt float32) Vec3 {
+	if dt <= 0 {
+		return current
+	}
+
+	omega, decay := smoothDampDecay(smoothTime, dt)
+	change := current.Sub(target)
+	temp := velocity.Add(change.Mul(omega)).Mul(dt)
+	*velocity = velocity.Sub(temp.Mul(omega)).Mul(decay)
+	output := target.Add(change.Add(temp).Mul(decay))
+
+	// Prevent overshooting
+	if target.Sub(current).Dot(output.Sub(target)) > 0 {
+		*velocity = Vec3{}
+		return target
+	}
+
+	return output
+}
+
+// SmoothDampScalar is the scalar version of SmoothDamp.
+func SmoothDampScalar(current, target float32, velocity *float32, smoothTime, dt float32) float32 {
+	if dt <= 0 {
+		return current
+	}
+
+	omega, decay := smoothDampDecay(smoothTime, dt)
+	change := current - target
+	temp := (*velocity + omega*change) * dt
+	*velocity = (*velocity - omega*temp) * decay
+	output := target + (change+temp)*decay
+
+	// Prevent overshooting
+	if (target-current)*(output-target) > 0 {
+		*velocity = 0
+		return target
+	}
+
+	return output
+}
+
+// QuatSmoothDamp is the rotation version of SmoothDamp: it moves the unit quaternion
+// current toward target as a critically damped spring. The quaternion is treated as a
+// 4D vector, flipped onto the same hemisphere as current so that it turns the short
+// way around, and renormalized after each step. *velocity holds the rate of change of
+// the quaternion's components and must be preserved between calls.
+func QuatSmoothDamp(current, target Quat, velocity *Quat, smoothTime, dt float32) Quat {
+	if dt <= 0 {
+		return current
+	}
+	if current.Dot(target) < 0 {
+		target = target.Scale(-1)
+	}
+
+	c := Vec4{current.W, current.V[0], current.V[1], current.V[2]}
+	t := Vec4{target.W, target.V[0], target.V[1], target.V[2]}
+	v := Vec4{velocity.W, velocity.V[0], velocity.V[1], velocity.V[2]}
+
+	omega, decay := smoothDampDecay(smoothTime, dt)
+	change := c.Sub(t)
+	temp := v.Add(change.Mul(omega)).Mul(dt)
+	v = v.Sub(temp.Mul(omega)).Mul(decay)
+	output := t.Add(change.Add(temp).Mul(decay))
+
+	if t.Sub(c).Dot(output.Sub(t)) > 0 {
+		output, v = t, Vec4{}
+	}
+
+	*velocity = Quat{v[0], Vec3{v[1], v[2], v[3]}}
+	return Quat{output[0], Vec3{output[1], output[2], output[3]}}.Normalize()
+}
@@ -0,0 +1,127 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestVec3SmootherStep(t *testing.T) {
+	start, target := Vec3{0, 0, 0}, Vec3{10, -5, 2}
+
+	for _, s := range []*Vec3Smoother{NewVec3Smoother(0.2), NewVec3WindowSmoother(8)} {
+		if r := s.Push(start); r != start {
+			t.Errorf("first Push(%v) != %v (got %v)", start, start, r)
+		}
+
+		// Feed a step: the output must approach the target monotonically
+		prevDist := target.Sub(start).Len()
+		for i := 0; i < 50; i++ {
+			r := s.Push(target)
+			d := target.Sub(r).Len()
+			if d > prevDist {
+				t.Errorf("Push(%v) #%d moved away from the target (%v > %v)", target, i, d, prevDist)
+			}
+			prevDist = d
+		}
+
+		if r := s.Value(); !r.EqualThreshold(target, 1e-3) {
+			t.Errorf("Vec3Smoother did not converge to %v (got %v)", target, r)
+		}
+
+		s.Reset()
+		if r := s.Push(start); r != start {
+			t.Errorf("Push(%v) after Reset != %v (got %v)", start, start, r)
+		}
+	}
+}
+
+func TestVec3Smoother(t *testing.T) {
+	s := NewVec3Smoother(0.25)
+	s.Push(Vec3{0, 0, 0})
+	if r, e := s.Push(Vec3{4, 8, -4}), (Vec3{1, 2, -1}); !r.EqualThreshold(e, 1e-6) {
+		t.Errorf("Vec3Smoother(0.25).Push != %v (got %v)", e, r)
+	}
+
+	w := NewVec3WindowSmoother(3)
+	for i, e := range []Vec3{{3, 0, 0}, {4.5, 0, 0}, {6, 0, 0}, {9, 0, 0}} {
+		if r := w.Push(Vec3{float32(3 * (i + 1)), 0, 0}); !r.EqualThreshold(e, 1e-6) {
+			t.Errorf("Vec3WindowSmoother(3) push #%d != %v (got %v)", i, e, r)
+		}
+	}
+}
+
+func TestSmoothDamp(t *testing.T) {
+	target := Vec3{10, -4, 3}
+
+	for _, initial := range []Vec3{{0, 0, 0}, {-20, 40, 0}} {
+		current, velocity := Vec3{}, initial
+		prevDist := target.Len()
+		for i := 0; i < 300; i++ {
+			current = SmoothDamp(current, target, &velocity, 0.3, 1.0/60)
+
+			// Moving past the target along the direction of travel is an overshoot
+			if target.Sub(current).Dot(target) < -1e-4 {
+				t.Errorf("SmoothDamp overshot %v (got %v at step %d)", target, current, i)
+				break
+			}
+
+			// Starting at rest, the distance to the target only ever shrinks
+			d := target.Sub(current).Len()
+			if initial == (Vec3{}) && d > prevDist+1e-5 {
+				t.Errorf("SmoothDamp moved away from %v at step %d (%v > %v)", target, i, d, prevDist)
+			}
+			prevDist = d
+		}
+
+		if !current.EqualThreshold(target, 1e-3) {
+			t.Errorf("SmoothDamp did not converge to %v (got %v, velocity %v)", target, current, velocity)
+		}
+	}
+
+	if r := SmoothDamp(Vec3{1, 2, 3}, target, new(Vec3), 0.3, 0); r != (Vec3{1, 2, 3}) {
+		t.Errorf("SmoothDamp with dt 0 != %v (got %v)", Vec3{1, 2, 3}, r)
+	}
+}
+
+func TestSmoothDampScalar(t *testing.T) {
+	var current, velocity float32
+	prev := current
+	for i := 0; i < 300; i++ {
+		current = SmoothDampScalar(current, 5, &velocity, 0.5, 1.0/30)
+		if current > 5 || current < prev {
+			t.Errorf("SmoothDampScalar step %d is not monotonic toward 5 without overshoot (got %v after %v)", i, current, prev)
+			break
+		}
+		prev = current
+	}
+
+	if !FloatEqualThreshold(current, 5, 1e-4) {
+		t.Errorf("SmoothDampScalar did not converge to 5 (got %v)", current)
+	}
+}
+
+func TestQuatSmoothDamp(t *testing.T) {
+	current, target := QuatIdent(), QuatRotate(2.5, Vec3{1, 1, 0}.Normalize())
+
+	// The negated target is the same rotation and must be reached the same way
+	for _, tgt := range []Quat{target, target.Scale(-1)} {
+		q, velocity := current, Quat{}
+		prevAngle := QuatAngleBetween(q, tgt)
+		for i := 0; i < 300; i++ {
+			q = QuatSmoothDamp(q, tgt, &velocity, 0.3, 1.0/60)
+			angle := QuatAngleBetween(q, tgt)
+			if angle > prevAngle+1e-5 {
+				t.Errorf("QuatSmoothDamp moved away from %v at step %d (%v > %v)", tgt, i, angle, prevAngle)
+				break
+			}
+			prevAngle = angle
+		}
+
+		if !q.OrientationEqualThreshold(tgt, 1e-4) {
+			t.Errorf("QuatSmoothDamp did not converge to %v (got %v)", tgt, q)
+		}
+	}
+}
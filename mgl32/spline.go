@@ -0,0 +1,207 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"sort"
+)
+
+// splineSamplesPerSegment is the number of chords each segment is
+// divided into when approximating the arc length of a Spline.
+const splineSamplesPerSegment = 32
+
+// A Spline is a Catmull-Rom spline passing through a list of control points.
+// Unlike CatmullRomCurve3D, which only evaluates a single segment, a Spline takes care
+// of picking the right segment for a given parameter.
+//
+// The spline passes through every control point. The first and last segments
+// are shaped with phantom points mirrored from their neighbors (p[-1] = 2*p[0] - p[1],
+// and similarly at the end), so the curve starts and ends exactly at the first and last
+// control points.
+//
+// A Spline precomputes an arc length table on construction, so the control points
+// may not be changed afterwards. Build a new spline instead.
+type Spline struct {
+	points []Vec3
+
+	// lengths[i] is the arc length from the start of the spline to the
+	// parameter i/splineSamplesPerSegment.
+	lengths []float32
+}
+
+// NewSpline creates a Catmull-Rom spline through the given control points.
+// The points are copied.
+func NewSpline(points ...Vec3) *Spline {
+	s := &Spline{points: append([]Vec3{}, points...)}
+
+	if len(points) < 2 {
+		s.lengths = []float32{0}
+		return s
+	}
+
+	n := (len(points) - 1) * splineSamplesPerSegment
+	s.lengths = make([]float32, n+1)
+	prev := s.Point(0)
+	for i := 1; i <= n; i++ {
+		curr := s.Point(float32(i) / splineSamplesPerSegment)
+		s.lengths[i] = s.lengths[i-1] + curr.Sub(prev).Len()
+		prev = curr
+	}
+
+	return s
+}
+
+// Points returns the control points of the spline.
+// The returned slice must not be modified.
+func (s *Spline) Points() []Vec3 {
+	return s.points
+}
+
+// Point returns the point on the spline at parameter t, which ranges over [0, N-1]
+// where N is the number of control points. Integer values of t correspond to the control
+// points themselves, so Point(2) is the third control point. Values of t outside of the
+// range are clamped to it.
+//
+// A spline with a single control point always returns it, and an empty spline returns
+// the zero vector.
+func (s *Spline) Point(t float32) Vec3 {
+	switch len(s.points) {
+	case 0:
+		return Vec3{}
+	case 1:
+		return s.points[0]
+	}
+
+	last := len(s.points) - 1
+	t = Clamp(t, 0, float32(last))
+
+	seg := int(t)
+	if seg >= last {
+		seg = last - 1
+	}
+
+	p1, p2 := s.points[seg], s.points[seg+1]
+
+	var p0, p3 Vec3
+	if seg > 0 {
+		p0 = s.points[seg-1]
+	} else {
+		p0 = p1.Mul(2).Sub(p2)
+	}
+	if seg+2 <= last {
+		p3 = s.points[seg+2]
+	} else {
+		p3 = p2.Mul(2).Sub(p1)
+	}
+
+	return CatmullRomCurve3D(Clamp(t-float32(seg), 0, 1), p0, p1, p2, p3)
+}
+
+// Length returns the approximate arc length of the whole spline. It is computed
+// by summing the lengths of short chords along each segment.
+func (s *Spline) Length() float32 {
+	return s.lengths[len(s.lengths)-1]
+}
+
+// PointAtDistance returns the point reached after travelling the arc length dist along
+// the spline from its first control point. The distance is clamped to [0, Length()].
+//
+// Unlike Point, evenly spaced distances yield evenly spaced points regardless of how far
+// apart the control points are.
+func (s *Spline) PointAtDistance(dist float32) Vec3 {
+	if len(s.points) < 2 {
+		return s.Point(0)
+	}
+
+	dist = Clamp(dist, 0, s.Length())
+
+	// First sample whose length is >= dist
+	i := sort.Search(len(s.lengths), func(i int) bool { return s.lengths[i] >= dist })
+	if i == 0 {
+		return s.Point(0)
+	}
+
+	l0, l1 := s.lengths[i-1], s.lengths[i]
+	frac := float32(0)
+	if l1 > l0 {
+		frac = (dist - l0) / (l1 - l0)
+	}
+
+	return s.Point((float32(i-1) + frac) / splineSamplesPerSegment)
+}
+
+// A Polyline is a piecewise-linear path through a list of points, such as a list of
+// waypoints to follow. Unlike a Spline, it moves in straight lines between the points
+// and has sharp corners at them.
+//
+// A Polyline precomputes the cumulative length up to each point on construction, so the
+// points may not be changed afterwards. Build a new polyline instead.
+type Polyline struct {
+	points []Vec3
+
+	// lengths[i] is the distance along the path from the first point to points[i].
+	lengths []float32
+}
+
+// NewPolyline creates a polyline through the given points. The points are copied.
+func NewPolyline(points ...Vec3) *Polyline {
+	p := &Polyline{
+		points:  append([]Vec3{}, points...),
+		lengths: make([]float32, len(points)),
+	}
+
+	for i := 1; i < len(points); i++ {
+		p.lengths[i] = p.lengths[i-1] + points[i].Sub(points[i-1]).Len()
+	}
+
+	return p
+}
+
+// Points returns the points of the polyline.
+// The returned slice must not be modified.
+func (p *Polyline) Points() []Vec3 {
+	return p.points
+}
+
+// Length returns the total length of the polyline, the sum of the lengths of its
+// segments. An empty polyline, or one with a single point, has length zero.
+func (p *Polyline) Length() float32 {
+	if len(p.lengths) == 0 {
+		return 0
+	}
+	return p.lengths[len(p.lengths)-1]
+}
+
+// PointAtDistance returns the point reached after travelling the distance s along the
+// polyline from its first point. The distance is clamped to [0, Length()], so the path
+// doesn't extrapolate past its ends.
+//
+// A polyline with a single point always returns it, and an empty polyline returns the
+// zero vector.
+func (p *Polyline) PointAtDistance(s float32) Vec3 {
+	switch len(p.points) {
+	case 0:
+		return Vec3{}
+	case 1:
+		return p.points[0]
+	}
+
+	s = Clamp(s, 0, p.Length())
+
+	// First point at least s along the path; the answer lies on the segment ending there
+	i := sort.Search(len(p.lengths), func(i int) bool { return p.lengths[i] >= s })
+	if i == 0 {
+		return p.points[0]
+	}
+
+	l0, l1 := p.lengths[i-1], p.lengths[i]
+	frac := float32(0)
+	if l1 > l0 {
+		frac = (s - l0) / (l1 - l0)
+	}
+
+	a, b := p.points[i-1], p.points[i]
+	return a.Add(b.Sub(a).Mul(frac))
+}
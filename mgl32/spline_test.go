@@ -0,0 +1,179 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestCatmullRomCurve3D(t *testing.T) {
+	p0, p1, p2, p3 := Vec3{0, 0, 0}, Vec3{1, 1, 0}, Vec3{2, -1, 1}, Vec3{3, 0, 0}
+
+	if r := CatmullRomCurve3D(0, p0, p1, p2, p3); !r.ApproxEqualThreshold(p1, 1e-6) {
+		t.Errorf("CatmullRomCurve3D at t=0 != %v (got %v)", p1, r)
+	}
+
+	if r := CatmullRomCurve3D(1, p0, p1, p2, p3); !r.ApproxEqualThreshold(p2, 1e-6) {
+		t.Errorf("CatmullRomCurve3D at t=1 != %v (got %v)", p2, r)
+	}
+
+	// Collinear, evenly spaced points give a straight line at constant speed
+	a, b, c, d := Vec3{0, 0, 0}, Vec3{1, 0, 0}, Vec3{2, 0, 0}, Vec3{3, 0, 0}
+	if r := CatmullRomCurve3D(0.25, a, b, c, d); !r.ApproxEqualThreshold(Vec3{1.25, 0, 0}, 1e-6) {
+		t.Errorf("CatmullRomCurve3D on a line at t=0.25 != %v (got %v)", Vec3{1.25, 0, 0}, r)
+	}
+}
+
+func TestCardinalSpline(t *testing.T) {
+	p0, p1, p2, p3 := Vec3{0, 0, 0}, Vec3{1, 1, 0}, Vec3{2, -1, 1}, Vec3{3, 0, 0}
+
+	for _, u := range []float32{0, 0.1, 0.25, 0.5, 0.8, 1} {
+		expected := CatmullRomCurve3D(u, p0, p1, p2, p3)
+		if r := CardinalSpline(p0, p1, p2, p3, 0.5, u); !r.EqualThreshold(expected, 1e-5) {
+			t.Errorf("CardinalSpline with tension 0.5 at t=%v != %v (got %v)", u, expected, r)
+		}
+	}
+
+	// The end points are interpolated regardless of tension
+	for _, tension := range []float32{0, 0.3, 1} {
+		if r := CardinalSpline(p0, p1, p2, p3, tension, 0); !r.EqualThreshold(p1, 1e-6) {
+			t.Errorf("CardinalSpline(tension %v) at t=0 != %v (got %v)", tension, p1, r)
+		}
+		if r := CardinalSpline(p0, p1, p2, p3, tension, 1); !r.EqualThreshold(p2, 1e-6) {
+			t.Errorf("CardinalSpline(tension %v) at t=1 != %v (got %v)", tension, p2, r)
+		}
+	}
+
+	// With zero tension the midpoint is halfway between p1 and p2
+	if r := CardinalSpline(p0, p1, p2, p3, 0, 0.5); !r.EqualThreshold(p1.Add(p2).Mul(0.5), 1e-6) {
+		t.Errorf("CardinalSpline with tension 0 at t=0.5 != %v (got %v)", p1.Add(p2).Mul(0.5), r)
+	}
+}
+
+func TestSplinePassesThroughControlPoints(t *testing.T) {
+	points := []Vec3{{0, 0, 0}, {1, 2, 0}, {3, 3, 1}, {4, 0, -1}, {6, 1, 0}}
+	s := NewSpline(points...)
+
+	eq := absEqual(1e-5)
+	for i, p := range points {
+		if r := s.Point(float32(i)); !r.ApproxFuncEqual(p, eq) {
+			t.Errorf("Spline.Point(%d) != %v (got %v)", i, p, r)
+		}
+	}
+
+	if r := s.Point(-1); !r.ApproxFuncEqual(points[0], eq) {
+		t.Errorf("Spline.Point(-1) is not clamped to %v (got %v)", points[0], r)
+	}
+
+	if r := s.Point(10); !r.ApproxFuncEqual(points[len(points)-1], eq) {
+		t.Errorf("Spline.Point(10) is not clamped to %v (got %v)", points[len(points)-1], r)
+	}
+}
+
+func TestSplineLength(t *testing.T) {
+	// Evenly spaced collinear points make a straight line
+	s := NewSpline(Vec3{0, 0, 0}, Vec3{1, 0, 0}, Vec3{2, 0, 0}, Vec3{3, 0, 0})
+
+	if l := s.Length(); !FloatEqualThreshold(l, 3, 1e-4) {
+		t.Errorf("Spline.Length() of straight line != 3 (got %v)", l)
+	}
+
+	eq := absEqual(1e-4)
+	for _, d := range []float32{0, 0.5, 1.5, 2.25, 3} {
+		if r := s.PointAtDistance(d); !r.ApproxFuncEqual(Vec3{d, 0, 0}, eq) {
+			t.Errorf("Spline.PointAtDistance(%v) != %v (got %v)", d, Vec3{d, 0, 0}, r)
+		}
+	}
+
+	if r := s.PointAtDistance(100); !r.ApproxFuncEqual(Vec3{3, 0, 0}, eq) {
+		t.Errorf("Spline.PointAtDistance(100) is not clamped to the end (got %v)", r)
+	}
+
+	// A curved spline is longer than the polyline through its points
+	c := NewSpline(Vec3{0, 0, 0}, Vec3{1, 1, 0}, Vec3{2, 0, 0}, Vec3{3, 1, 0})
+	chords := float32(3 * 1.41421356)
+	if l := c.Length(); l < chords {
+		t.Errorf("Spline.Length() of curved spline is shorter than its chords: %v < %v", l, chords)
+	}
+
+	// Evenly spaced distances give (roughly) evenly spaced points
+	step := c.Length() / 20
+	for i := 0; i < 20; i++ {
+		p1, p2 := c.PointAtDistance(float32(i)*step), c.PointAtDistance(float32(i+1)*step)
+		if d := p2.Sub(p1).Len(); !FloatEqualThreshold(d, step, 5e-2) {
+			t.Errorf("Spline.PointAtDistance samples %d and %d are %v apart, expected about %v", i, i+1, d, step)
+		}
+	}
+}
+
+func TestSplineDegenerate(t *testing.T) {
+	if r := NewSpline().Point(0.5); r != (Vec3{}) {
+		t.Errorf("Empty spline did not return zero vector (got %v)", r)
+	}
+
+	s := NewSpline(Vec3{1, 2, 3})
+	if r := s.Point(0.5); r != (Vec3{1, 2, 3}) {
+		t.Errorf("Single point spline did not return its point (got %v)", r)
+	}
+	if l := s.Length(); l != 0 {
+		t.Errorf("Single point spline has non-zero length %v", l)
+	}
+	if r := s.PointAtDistance(1); r != (Vec3{1, 2, 3}) {
+		t.Errorf("Single point spline PointAtDistance did not return its point (got %v)", r)
+	}
+}
+
+func TestPolyline(t *testing.T) {
+	// Two segments of lengths 4 and 2, turning a corner at (4, 0, 0)
+	p := NewPolyline(Vec3{0, 0, 0}, Vec3{4, 0, 0}, Vec3{4, 2, 0})
+
+	if l := p.Length(); !FloatEqual(l, 6) {
+		t.Errorf("Polyline.Length() != 6 (got %v)", l)
+	}
+
+	tests := []struct {
+		Distance float32
+		Expected Vec3
+	}{
+		{0, Vec3{0, 0, 0}},
+		{1, Vec3{1, 0, 0}},
+		{3, Vec3{3, 0, 0}}, // The midpoint by distance is on the first, longer segment
+		{4, Vec3{4, 0, 0}},
+		{5, Vec3{4, 1, 0}},
+		{6, Vec3{4, 2, 0}},
+		{-1, Vec3{0, 0, 0}},
+		{100, Vec3{4, 2, 0}},
+	}
+
+	for _, c := range tests {
+		if r := p.PointAtDistance(c.Distance); !r.EqualThreshold(c.Expected, 1e-6) {
+			t.Errorf("Polyline.PointAtDistance(%v) != %v (got %v)", c.Distance, c.Expected, r)
+		}
+	}
+
+	// Repeated points make zero-length segments, which are skipped over
+	d := NewPolyline(Vec3{0, 0, 0}, Vec3{0, 0, 0}, Vec3{0, 0, 2}, Vec3{0, 0, 2})
+	if r := d.PointAtDistance(1); !r.EqualThreshold(Vec3{0, 0, 1}, 1e-6) {
+		t.Errorf("Polyline.PointAtDistance(1) with repeated points != %v (got %v)", Vec3{0, 0, 1}, r)
+	}
+}
+
+func TestPolylineDegenerate(t *testing.T) {
+	e := NewPolyline()
+	if r := e.PointAtDistance(0.5); r != (Vec3{}) {
+		t.Errorf("Empty polyline did not return zero vector (got %v)", r)
+	}
+	if l := e.Length(); l != 0 {
+		t.Errorf("Empty polyline has non-zero length %v", l)
+	}
+
+	p := NewPolyline(Vec3{1, 2, 3})
+	if r := p.PointAtDistance(1); r != (Vec3{1, 2, 3}) {
+		t.Errorf("Single point polyline did not return its point (got %v)", r)
+	}
+	if l := p.Length(); l != 0 {
+		t.Errorf("Single point polyline has non-zero length %v", l)
+	}
+}
@@ -180,12 +180,213 @@ func ExtractMaxScale(m Mat4) float32 {
 	return float32(math.Sqrt(math.Max(scaleX, math.Max(scaleY, scaleZ))))
 }
 
+// Translation returns the translation component of a homogeneous matrix,
+// that is, the X, Y, and Z elements of the last column.
+func (m Mat4) Translation() Vec3 {
+	return Vec3{m[12], m[13], m[14]}
+}
+
+// SetTranslation overwrites the translation component of a homogeneous matrix
+// with v. The upper-left 3x3 (rotation, scale and shear) is left untouched.
+// This has a pointer receiver because it mutates the matrix.
+func (m *Mat4) SetTranslation(v Vec3) {
+	m[12], m[13], m[14] = v[0], v[1], v[2]
+}
+
+// Round snaps every element of the matrix that is within epsilon of an integer
+// to that integer, leaving all other elements untouched. This cleans up values like
+// 0.9999998 that accumulate after a series of operations, e.g. before serialization.
+//
+// This is unrelated to the package level Round function, which rounds to a number of
+// decimal places.
+func (m Mat4) Round(epsilon float32) Mat4 {
+	for i, v := range m {
+		r := float32(math.Floor(float64(v) + 0.5))
+		if Abs(v-r) <= epsilon {
+			m[i] = r
+		}
+	}
+
+	return m
+}
+
+// MulAll multiplies the given matrices together from left to right, so
+// MulAll(a, b, c) is equivalent to a.Mul4(b).Mul4(c). This is handy for
+// building a world matrix out of a chain of local transforms.
+//
+// Calling this with no arguments returns the identity.
+func MulAll(mats ...Mat4) Mat4 {
+	res := Ident4()
+	for _, m := range mats {
+		res = res.Mul4(m)
+	}
+
+	return res
+}
+
 // Calculates the Normal of the Matrix (aka the inverse transpose)
 func Mat4Normal(m Mat4) Mat3 {
 	n := m.Inv().Transpose()
 	return Mat3{n[0], n[1], n[2], n[4], n[5], n[6], n[8], n[9], n[10]}
 }
 
+// TangentSpaceMatrix builds the TBN matrix used for normal mapping, whose columns are
+// the tangent, bitangent and normal. The tangent is made orthogonal to the normal with
+// Gram-Schmidt, and the bitangent is normal x tangent, so the result is orthonormal.
+//
+// If the tangent is (nearly) parallel to the normal, or zero, an arbitrary tangent
+// perpendicular to the normal is used instead.
+func TangentSpaceMatrix(normal, tangent Vec3) Mat3 {
+	n := normal.Normalize()
+	t := tangent.Sub(n.Mul(n.Dot(tangent)))
+
+	if t.Len() <= 1e-4*tangent.Len() || t.Len() <= Epsilon {
+		t = perpendicularTo(n)
+	}
+
+	t = t.Normalize()
+	return Mat3FromCols(t, n.Cross(t), n)
+}
+
+// TangentBitangent computes the tangent space of the triangle p0 p1 p2 from its texture
+// coordinates uv0 uv1 uv2: the unit tangent points along increasing U on the surface,
+// and the unit bitangent along increasing V. They are generally not orthogonal to each
+// other or to the normal; pass the tangent to TangentSpaceMatrix along with a vertex
+// normal to get an orthonormal TBN matrix.
+//
+// If the texture coordinates are degenerate (they don't span an area, so the mapping
+// can't be inverted), an arbitrary tangent perpendicular to the triangle's normal is
+// returned, with the bitangent completing a right-handed frame. If the triangle itself
+// is degenerate, the X and Y axes are returned.
+func TangentBitangent(p0, p1, p2 Vec3, uv0, uv1, uv2 Vec2) (tangent, bitangent Vec3) {
+	e1, e2 := p1.Sub(p0), p2.Sub(p0)
+	d1, d2 := uv1.Sub(uv0), uv2.Sub(uv0)
+
+	det := d1.Cross(d2)
+	if det == 0 {
+		n := e1.Cross(e2)
+		if n.Len() == 0 {
+			return Vec3{1, 0, 0}, Vec3{0, 1, 0}
+		}
+		n = n.Normalize()
+		tangent = perpendicularTo(n)
+		return tangent, n.Cross(tangent)
+	}
+
+	// Solve [e1 e2] = [T B] [d1 d2] for T and B
+	tangent = e1.Mul(d2[1]).Sub(e2.Mul(d1[1]))
+	bitangent = e2.Mul(d1[0]).Sub(e1.Mul(d2[0]))
+	if det < 0 {
+		tangent, bitangent = tangent.Mul(-1), bitangent.Mul(-1)
+	}
+
+	return tangent.Normalize(), bitangent.Normalize()
+}
+
+// BillboardMatrix generates the model matrix of a spherical billboard at objPos: a
+// sprite that always turns to face the camera. The geometry is expected to lie in its
+// local XY plane, facing +Z. The matrix maps +Z to the direction from the object to the
+// camera and keeps +Y as close to camUp as possible.
+//
+// If the camera is directly above or below the object (along camUp), an arbitrary
+// up axis perpendicular to the view direction is used.
+func BillboardMatrix(objPos, camPos, camUp Vec3) Mat4 {
+	f := camPos.Sub(objPos).Normalize()
+	r := camUp.Cross(f)
+	if r.Len() <= 1e-4*camUp.Len() || r.Len() <= Epsilon {
+		r = perpendicularTo(f)
+	}
+	r = r.Normalize()
+	u := f.Cross(r)
+
+	return Mat4{
+		r[0], r[1], r[2], 0,
+		u[0], u[1], u[2], 0,
+		f[0], f[1], f[2], 0,
+		objPos[0], objPos[1], objPos[2], 1,
+	}
+}
+
+// CylindricalBillboardMatrix generates the model matrix of a cylindrical billboard at
+// objPos, such as a tree or a flame, which only rotates about axis to face the camera.
+// The geometry is expected to lie in its local XY plane, facing +Z. The matrix maps +Y
+// to axis and +Z to the direction from the object to the camera, flattened onto the
+// plane perpendicular to axis.
+//
+// If the camera lies on the axis itself, an arbitrary facing direction is used.
+func CylindricalBillboardMatrix(objPos, camPos, axis Vec3) Mat4 {
+	u := axis.Normalize()
+	toCam := camPos.Sub(objPos)
+	f := toCam.Sub(u.Mul(u.Dot(toCam)))
+	if f.Len() <= 1e-4*toCam.Len() || f.Len() <= Epsilon {
+		f = perpendicularTo(u)
+	}
+	f = f.Normalize()
+	r := u.Cross(f)
+
+	return Mat4{
+		r[0], r[1], r[2], 0,
+		u[0], u[1], u[2], 0,
+		f[0], f[1], f[2], 0,
+		objPos[0], objPos[1], objPos[2], 1,
+	}
+}
+
+// ReflectionMatrix returns the matrix that mirrors points across the plane
+// {p : n.Dot(p) + d = 0}, given as Vec4{n[0], n[1], n[2], d}, as used for rendering
+// planar reflections. The normal doesn't need to be normalized.
+//
+// Since a reflection reverses the winding order of triangles, the culling mode
+// usually needs to be flipped when drawing with this matrix.
+func ReflectionMatrix(plane Vec4) Mat4 {
+	plane = plane.Mul(1 / plane.Vec3().Len())
+	a, b, c, d := plane[0], plane[1], plane[2], plane[3]
+
+	return Mat4{
+		1 - 2*a*a, -2 * a * b, -2 * a * c, 0,
+		-2 * a * b, 1 - 2*b*b, -2 * b * c, 0,
+		-2 * a * c, -2 * b * c, 1 - 2*c*c, 0,
+		-2 * a * d, -2 * b * d, -2 * c * d, 1,
+	}
+}
+
+// YUpToZUp returns the rotation converting coordinates from a right-handed Y-up
+// system (OpenGL, glTF, Maya) to a right-handed Z-up system (Blender, 3ds Max). It is
+// a rotation of 90 degrees about the X-axis, so the up vector (0, 1, 0) maps to
+// (0, 0, 1) and the forward vector (0, 0, 1) maps to (0, -1, 0).
+func YUpToZUp() Mat4 {
+	return Mat4{
+		1, 0, 0, 0,
+		0, 0, 1, 0,
+		0, -1, 0, 0,
+		0, 0, 0, 1,
+	}
+}
+
+// ZUpToYUp returns the rotation converting coordinates from a right-handed Z-up system
+// to a right-handed Y-up system. It is the inverse of YUpToZUp.
+func ZUpToYUp() Mat4 {
+	return Mat4{
+		1, 0, 0, 0,
+		0, 0, -1, 0,
+		0, 1, 0, 0,
+		0, 0, 0, 1,
+	}
+}
+
+// perpendicularTo returns an arbitrary unit vector perpendicular to the unit vector v,
+// built from the world axis least aligned with v.
+func perpendicularTo(v Vec3) Vec3 {
+	axis := Vec3{1, 0, 0}
+	if Abs(v[1]) < Abs(v[0]) && Abs(v[1]) <= Abs(v[2]) {
+		axis = Vec3{0, 1, 0}
+	} else if Abs(v[2]) < Abs(v[0]) {
+		axis = Vec3{0, 0, 1}
+	}
+
+	return axis.Sub(v.Mul(v.Dot(axis))).Normalize()
+}
+
 // Multiplies a 3D vector by a transformation given by
 // the homogeneous 4D matrix m, applying any translation.
 // If this transformation is non-affine, it will project this
@@ -0,0 +1,189 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// A Transform is an affine transformation stored in decomposed form as a
+// translation, a rotation, and a (possibly non-uniform) scale. When applied
+// to a point the scale is applied first, then the rotation, then the translation,
+// which makes it equivalent to the matrix T * R * S.
+//
+// Keeping the parts separate (as opposed to baking them into a Mat4) makes it
+// cheap to interpolate and to invert transformations, which is what most
+// animation systems need.
+type Transform struct {
+	Translation Vec3
+	Rotation    Quat
+	Scale       Vec3
+}
+
+// TransformIdent returns the identity transform: no translation,
+// the identity rotation, and a scale of 1 on every axis.
+func TransformIdent() Transform {
+	return Transform{Vec3{0, 0, 0}, QuatIdent(), Vec3{1, 1, 1}}
+}
+
+// Mat4 returns the homogeneous matrix corresponding to the transform,
+// that is T * R * S.
+func (t Transform) Mat4() Mat4 {
+	return ComposeTRS(t.Translation, t.Rotation, t.Scale)
+}
+
+// ComposeTRS builds the homogeneous matrix T * R * S from a translation, a rotation,
+// and a scale, in exactly that order: the scale is applied first, then the rotation,
+// then the translation. This is the convention used by glTF and most animation formats.
+//
+// The rotation is assumed to be a unit quaternion. This is the inverse of Decompose.
+func ComposeTRS(translation Vec3, rotation Quat, scale Vec3) Mat4 {
+	return Mat4FromQuatScaleTranslate(rotation, scale, translation)
+}
+
+// Mat4FromQuatScaleTranslate builds the homogeneous matrix T * R * S from a unit
+// quaternion, a scale, and a translation. It gives the same result as
+// Translate3D(...).Mul4(q.Mat4()).Mul4(Scale3D(...)), but writes each element
+// directly instead of building and multiplying the intermediate matrices.
+func Mat4FromQuatScaleTranslate(q Quat, scale, translate Vec3) Mat4 {
+	w, x, y, z := q.W, q.V[0], q.V[1], q.V[2]
+	sx, sy, sz := scale[0], scale[1], scale[2]
+
+	return Mat4{
+		(1 - 2*y*y - 2*z*z) * sx, (2*x*y + 2*w*z) * sx, (2*x*z - 2*w*y) * sx, 0,
+		(2*x*y - 2*w*z) * sy, (1 - 2*x*x - 2*z*z) * sy, (2*y*z + 2*w*x) * sy, 0,
+		(2*x*z + 2*w*y) * sz, (2*y*z - 2*w*x) * sz, (1 - 2*x*x - 2*y*y) * sz, 0,
+		translate[0], translate[1], translate[2], 1,
+	}
+}
+
+// ExtractScale returns the scale part of an affine matrix, that is, the length of each
+// of its first three basis columns. If the matrix contains a reflection (negative
+// determinant) the X scale is negated, matching the convention used by Decompose.
+func (m Mat4) ExtractScale() Vec3 {
+	scale := Vec3{m.Col(0).Vec3().Len(), m.Col(1).Vec3().Len(), m.Col(2).Vec3().Len()}
+	if m.Mat3().Det() < 0 {
+		scale[0] = -scale[0]
+	}
+
+	return scale
+}
+
+// RigidPart returns m with its scale removed, leaving only the rotation and
+// translation, as needed when handing a transform to a physics engine. Each of the
+// first three basis columns is normalized; if m contains a reflection (negative
+// determinant) the X column is also negated, matching ExtractScale, so the result is
+// always a proper rotation. The bottom row is reset to (0, 0, 0, 1).
+//
+// m must not have shear or a zero scale on any axis.
+func (m Mat4) RigidPart() Mat4 {
+	scale := m.ExtractScale()
+	for i := 0; i < 3; i++ {
+		m[i*4+0] /= scale[i]
+		m[i*4+1] /= scale[i]
+		m[i*4+2] /= scale[i]
+		m[i*4+3] = 0
+	}
+	m[15] = 1
+
+	return m
+}
+
+// Decompose splits an affine matrix into a translation, a rotation, and a scale such that
+// ComposeTRS(translation, rotation, scale) reproduces m.
+//
+// The scale of each axis is the length of the corresponding basis column. If the matrix
+// contains a reflection (negative determinant) the X scale is negated so that the
+// remaining rotation is proper. Matrices with shear, a projective part, or a zero scale on
+// any axis can't be represented this way, and the result is undefined for them.
+func (m Mat4) Decompose() (translation Vec3, rotation Quat, scale Vec3) {
+	translation = Vec3{m[12], m[13], m[14]}
+
+	x, y, z := m.Col(0).Vec3(), m.Col(1).Vec3(), m.Col(2).Vec3()
+	scale = m.ExtractScale()
+
+	rot := Mat4FromCols(
+		x.Mul(1/scale[0]).Vec4(0),
+		y.Mul(1/scale[1]).Vec4(0),
+		z.Mul(1/scale[2]).Vec4(0),
+		Vec4{0, 0, 0, 1},
+	)
+	rotation = Mat4ToQuat(rot).Normalize()
+
+	return translation, rotation, scale
+}
+
+// RotationEqual reports whether m1 and m2 have the same upper-left 3x3 part, that is,
+// the same rotation (and scale), ignoring translation and the bottom row. Elements are
+// compared with an absolute tolerance of eps, which suits rotation matrices since
+// their elements all lie in [-1, 1].
+func (m1 Mat4) RotationEqual(m2 Mat4, eps float32) bool {
+	for c := 0; c < 3; c++ {
+		for r := 0; r < 3; r++ {
+			if Abs(m1[c*4+r]-m2[c*4+r]) > eps {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// TransformPoint applies the full transform (scale, rotation, and translation)
+// to the point p.
+func (t Transform) TransformPoint(p Vec3) Vec3 {
+	return t.Rotation.Rotate(Vec3{p[0] * t.Scale[0], p[1] * t.Scale[1], p[2] * t.Scale[2]}).Add(t.Translation)
+}
+
+// TransformDirection applies the scale and rotation of the transform to the
+// direction d. Like TransformNormal, translation is ignored.
+func (t Transform) TransformDirection(d Vec3) Vec3 {
+	return t.Rotation.Rotate(Vec3{d[0] * t.Scale[0], d[1] * t.Scale[1], d[2] * t.Scale[2]})
+}
+
+// InverseTransformPoint maps the point p from the space the transform maps into
+// back to the transform's local space, undoing the translation, rotation, and
+// scale in reverse order. This is equivalent to transforming by t.Mat4().Inv()
+// without building or inverting a matrix.
+//
+// The rotation is assumed to be a unit quaternion, and every component of the
+// scale must be non-zero.
+func (t Transform) InverseTransformPoint(p Vec3) Vec3 {
+	return t.InverseTransformDirection(p.Sub(t.Translation))
+}
+
+// InverseTransformDirection undoes the rotation and scale of the transform on the
+// direction d, so that t.InverseTransformDirection(t.TransformDirection(d)) == d.
+// Like TransformDirection, translation is ignored.
+func (t Transform) InverseTransformDirection(d Vec3) Vec3 {
+	d = t.Rotation.Conjugate().Rotate(d)
+	return Vec3{d[0] / t.Scale[0], d[1] / t.Scale[1], d[2] / t.Scale[2]}
+}
+
+// LerpTransform interpolates between two transforms. The translation and scale
+// are linearly interpolated while the rotation is interpolated with QuatSlerp,
+// so the rotation moves at constant angular velocity.
+//
+// An amount of 0 yields a, and an amount of 1 yields b.
+func LerpTransform(a, b Transform, amount float32) Transform {
+	return Transform{
+		Translation: a.Translation.Add(b.Translation.Sub(a.Translation).Mul(amount)),
+		Rotation:    QuatSlerp(a.Rotation, b.Rotation, amount),
+		Scale:       a.Scale.Add(b.Scale.Sub(a.Scale).Mul(amount)),
+	}
+}
+
+// Mat4BlendRigid interpolates between two affine matrices without distorting them.
+// Blending the elements directly shears and shrinks the rotation part; instead both
+// matrices are split with Decompose, the parts are interpolated as in LerpTransform,
+// and the result is recomposed, so every intermediate matrix is a valid TRS transform.
+//
+// Since a matrix doesn't distinguish a quaternion from its negation, the rotation
+// always takes the shortest path. The same restrictions as Decompose apply to a and b.
+func Mat4BlendRigid(a, b Mat4, t float32) Mat4 {
+	ta, ra, sa := a.Decompose()
+	tb, rb, sb := b.Decompose()
+	if ra.Dot(rb) < 0 {
+		rb = rb.Scale(-1)
+	}
+
+	return LerpTransform(Transform{ta, ra, sa}, Transform{tb, rb, sb}, t).Mat4()
+}
@@ -0,0 +1,240 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTransformMat4(t *testing.T) {
+	tr := Transform{Vec3{1, 2, 3}, QuatRotate(math.Pi/3, Vec3{0, 1, 0}), Vec3{2, 3, 4}}
+	expected := Translate3D(1, 2, 3).Mul4(HomogRotate3D(math.Pi/3, Vec3{0, 1, 0})).Mul4(Scale3D(2, 3, 4))
+
+	if m := tr.Mat4(); !m.ApproxEqualThreshold(expected, 1e-4) {
+		t.Errorf("Transform(%v).Mat4() != %v (got %v)", tr, expected, m)
+	}
+
+	if m := TransformIdent().Mat4(); !m.ApproxEqual(Ident4()) {
+		t.Errorf("TransformIdent().Mat4() != %v (got %v)", Ident4(), m)
+	}
+}
+
+func TestComposeTRS(t *testing.T) {
+	rot := QuatRotate(0.7, Vec3{1, -2, 0.5}.Normalize())
+	expected := Translate3D(1, 2, 3).Mul4(rot.Mat4()).Mul4(Scale3D(2, 3, 4))
+
+	if m := ComposeTRS(Vec3{1, 2, 3}, rot, Vec3{2, 3, 4}); !m.ApproxEqualThreshold(expected, 1e-4) {
+		t.Errorf("ComposeTRS != %v (got %v)", expected, m)
+	}
+}
+
+func TestMat4FromQuatScaleTranslate(t *testing.T) {
+	for _, c := range []struct {
+		Q                Quat
+		Scale, Translate Vec3
+	}{
+		{QuatIdent(), Vec3{1, 1, 1}, Vec3{0, 0, 0}},
+		{QuatRotate(math.Pi/5, Vec3{0, 0, 1}), Vec3{2, 2, 2}, Vec3{1, -2, 3}},
+		{QuatRotate(2.1, Vec3{1, -1, 2}.Normalize()), Vec3{0.5, 3, -1}, Vec3{-4, 0, 7}},
+	} {
+		e := Translate3D(c.Translate[0], c.Translate[1], c.Translate[2]).
+			Mul4(c.Q.Mat4()).
+			Mul4(Scale3D(c.Scale[0], c.Scale[1], c.Scale[2]))
+
+		if r := Mat4FromQuatScaleTranslate(c.Q, c.Scale, c.Translate); !r.ApproxFuncEqual(e, absEqual(1e-5)) {
+			t.Errorf("Mat4FromQuatScaleTranslate(%v, %v, %v) != %v (got %v)", c.Q, c.Scale, c.Translate, e, r)
+		}
+	}
+}
+
+func TestMat4ExtractScale(t *testing.T) {
+	rot := QuatRotate(1.3, Vec3{-1, 2, 0.5}.Normalize())
+	for _, scale := range []Vec3{{1, 1, 1}, {3, 3, 3}, {0.5, 2, 7}, {-2, 1, 4}} {
+		m := ComposeTRS(Vec3{4, -5, 6}, rot, scale)
+		if r := m.ExtractScale(); !r.EqualThreshold(scale, 1e-5) {
+			t.Errorf("%v.ExtractScale() != %v (got %v)", m, scale, r)
+		}
+	}
+
+	// A reflection on any axis is reported on X
+	m := Scale3D(2, -3, 4)
+	if r, e := m.ExtractScale(), (Vec3{-2, 3, 4}); !r.EqualThreshold(e, 1e-5) {
+		t.Errorf("%v.ExtractScale() != %v (got %v)", m, e, r)
+	}
+}
+
+func TestMat4RigidPart(t *testing.T) {
+	rot := QuatRotate(0.9, Vec3{1, -2, 3}.Normalize())
+	translation := Vec3{3, -1, 8}
+
+	for _, scale := range []Vec3{{1, 1, 1}, {2, 2, 2}, {0.5, 3, 7}, {-2, 1, 4}} {
+		m := ComposeTRS(translation, rot, scale)
+		r := m.RigidPart()
+
+		for i := 0; i < 3; i++ {
+			if l := r.Col(i).Vec3().Len(); !FloatEqualThreshold(l, 1, 1e-5) {
+				t.Errorf("%v.RigidPart() column %d has length %v", m, i, l)
+			}
+		}
+
+		if det := r.Det(); !FloatEqualThreshold(det, 1, 1e-5) {
+			t.Errorf("%v.RigidPart() has determinant %v, expected 1", m, det)
+		}
+
+		if e := ComposeTRS(translation, rot, Vec3{1, 1, 1}); !r.ApproxFuncEqual(e, absEqual(1e-5)) {
+			t.Errorf("%v.RigidPart() != %v (got %v)", m, e, r)
+		}
+	}
+}
+
+func TestComposeDecomposeRoundTrip(t *testing.T) {
+	tests := []struct {
+		Translation Vec3
+		Rotation    Quat
+		Scale       Vec3
+	}{
+		{Vec3{0, 0, 0}, QuatIdent(), Vec3{1, 1, 1}},
+		{Vec3{1, 2, 3}, QuatRotate(math.Pi/3, Vec3{0, 1, 0}), Vec3{2, 2, 2}},
+		{Vec3{-5, 0.5, 10}, QuatRotate(2.5, Vec3{1, 1, 1}.Normalize()), Vec3{0.5, 0.5, 0.5}},
+		{Vec3{7, -3, 2}, QuatRotate(-1, Vec3{3, 0, -1}.Normalize()), Vec3{1, 2, 3}},
+	}
+
+	for _, c := range tests {
+		m := ComposeTRS(c.Translation, c.Rotation, c.Scale)
+		tr, rot, scale := m.Decompose()
+
+		if !tr.ApproxEqualThreshold(c.Translation, 1e-4) {
+			t.Errorf("Decompose translation != %v (got %v)", c.Translation, tr)
+		}
+		if !rot.OrientationEqualThreshold(c.Rotation, 1e-4) {
+			t.Errorf("Decompose rotation != %v (got %v)", c.Rotation, rot)
+		}
+		if !scale.ApproxEqualThreshold(c.Scale, 1e-4) {
+			t.Errorf("Decompose scale != %v (got %v)", c.Scale, scale)
+		}
+		if r := ComposeTRS(tr, rot, scale); !r.ApproxFuncEqual(m, absEqual(1e-4)) {
+			t.Errorf("ComposeTRS(Decompose(%v)) != %v (got %v)", m, m, r)
+		}
+	}
+
+	// Reflections are folded into a negative X scale
+	m := Scale3D(-2, 3, 4)
+	if _, rot, scale := m.Decompose(); !scale.ApproxEqualThreshold(Vec3{-2, 3, 4}, 1e-4) || !rot.OrientationEqualThreshold(QuatIdent(), 1e-4) {
+		t.Errorf("Decompose(%v) != (%v, %v) (got %v, %v)", m, QuatIdent(), Vec3{-2, 3, 4}, rot, scale)
+	}
+}
+
+func TestMat4RotationEqual(t *testing.T) {
+	rot := HomogRotate3D(0.8, Vec3{1, 2, 3}.Normalize())
+	a := Translate3D(1, 2, 3).Mul4(rot)
+	b := Translate3D(-5, 0, 10).Mul4(rot)
+
+	if !a.RotationEqual(b, 1e-6) {
+		t.Errorf("%v.RotationEqual(%v) != true", a, b)
+	}
+
+	c := Translate3D(1, 2, 3).Mul4(HomogRotate3D(0.81, Vec3{1, 2, 3}.Normalize()))
+	if a.RotationEqual(c, 1e-4) {
+		t.Errorf("%v.RotationEqual(%v) != false", a, c)
+	}
+
+	if !a.RotationEqual(c, 1e-1) {
+		t.Errorf("%v.RotationEqual(%v) with eps 0.1 != true", a, c)
+	}
+}
+
+func TestTransformPointDirection(t *testing.T) {
+	tr := Transform{Vec3{1, 2, 3}, QuatRotate(math.Pi/3, Vec3{0, 1, 0}), Vec3{2, 3, 4}}
+	m := tr.Mat4()
+	v := Vec3{-1, 5, 2}
+
+	if r, e := tr.TransformPoint(v), TransformCoordinate(v, m); !r.ApproxEqualThreshold(e, 1e-4) {
+		t.Errorf("Transform(%v).TransformPoint(%v) != %v (got %v)", tr, v, e, r)
+	}
+
+	if r, e := tr.TransformDirection(v), TransformNormal(v, m); !r.ApproxEqualThreshold(e, 1e-4) {
+		t.Errorf("Transform(%v).TransformDirection(%v) != %v (got %v)", tr, v, e, r)
+	}
+}
+
+func TestInverseTransformPointDirection(t *testing.T) {
+	tr := Transform{Vec3{1, 2, 3}, QuatRotate(math.Pi/3, Vec3{1, 1, 0}.Normalize()), Vec3{2, -3, 4}}
+	inv := tr.Mat4().Inv()
+
+	for _, v := range []Vec3{{0, 0, 0}, {-1, 5, 2}, {3, -0.5, 7}} {
+		if r := tr.InverseTransformPoint(tr.TransformPoint(v)); !r.EqualThreshold(v, 1e-4) {
+			t.Errorf("Transform(%v).InverseTransformPoint(TransformPoint(%v)) != %v (got %v)", tr, v, v, r)
+		}
+
+		if r := tr.TransformPoint(tr.InverseTransformPoint(v)); !r.EqualThreshold(v, 1e-4) {
+			t.Errorf("Transform(%v).TransformPoint(InverseTransformPoint(%v)) != %v (got %v)", tr, v, v, r)
+		}
+
+		if r, e := tr.InverseTransformPoint(v), TransformCoordinate(v, inv); !r.EqualThreshold(e, 1e-4) {
+			t.Errorf("Transform(%v).InverseTransformPoint(%v) != %v (got %v)", tr, v, e, r)
+		}
+
+		if r := tr.InverseTransformDirection(tr.TransformDirection(v)); !r.EqualThreshold(v, 1e-4) {
+			t.Errorf("Transform(%v).InverseTransformDirection(TransformDirection(%v)) != %v (got %v)", tr, v, v, r)
+		}
+
+		if r, e := tr.InverseTransformDirection(v), TransformNormal(v, inv); !r.EqualThreshold(e, 1e-4) {
+			t.Errorf("Transform(%v).InverseTransformDirection(%v) != %v (got %v)", tr, v, e, r)
+		}
+	}
+}
+
+func TestLerpTransform(t *testing.T) {
+	a := Transform{Vec3{0, 0, 0}, QuatIdent(), Vec3{1, 1, 1}}
+	b := Transform{Vec3{2, 4, -6}, QuatRotate(math.Pi/2, Vec3{0, 0, 1}), Vec3{3, 3, 5}}
+
+	eq := func(x, y Transform) bool {
+		return x.Translation.ApproxEqualThreshold(y.Translation, 1e-4) &&
+			x.Rotation.OrientationEqualThreshold(y.Rotation, 1e-4) &&
+			x.Scale.ApproxEqualThreshold(y.Scale, 1e-4)
+	}
+
+	if r := LerpTransform(a, b, 0); !eq(r, a) {
+		t.Errorf("LerpTransform(%v, %v, 0) != %v (got %v)", a, b, a, r)
+	}
+
+	if r := LerpTransform(a, b, 1); !eq(r, b) {
+		t.Errorf("LerpTransform(%v, %v, 1) != %v (got %v)", a, b, b, r)
+	}
+
+	mid := Transform{Vec3{1, 2, -3}, QuatSlerp(a.Rotation, b.Rotation, 0.5), Vec3{2, 2, 3}}
+	if r := LerpTransform(a, b, 0.5); !eq(r, mid) {
+		t.Errorf("LerpTransform(%v, %v, 0.5) != %v (got %v)", a, b, mid, r)
+	}
+
+	if r, e := LerpTransform(a, b, 0.5).Rotation, QuatRotate(math.Pi/4, Vec3{0, 0, 1}); !r.OrientationEqualThreshold(e, 1e-4) {
+		t.Errorf("LerpTransform midpoint rotation != %v (got %v)", e, r)
+	}
+}
+
+func TestMat4BlendRigid(t *testing.T) {
+	ra := QuatRotate(0.4, Vec3{1, 1, 0}.Normalize())
+	rb := QuatRotate(2.5, Vec3{0, -1, 2}.Normalize())
+	a := ComposeTRS(Vec3{1, 2, 3}, ra, Vec3{1, 2, 1})
+	b := ComposeTRS(Vec3{-3, 0, 5}, rb, Vec3{3, 2, 5})
+
+	if r := Mat4BlendRigid(a, b, 0); !r.ApproxFuncEqual(a, absEqual(1e-4)) {
+		t.Errorf("Mat4BlendRigid(%v, %v, 0) != %v (got %v)", a, b, a, r)
+	}
+	if r := Mat4BlendRigid(a, b, 1); !r.ApproxFuncEqual(b, absEqual(1e-4)) {
+		t.Errorf("Mat4BlendRigid(%v, %v, 1) != %v (got %v)", a, b, b, r)
+	}
+
+	// The midpoint is a proper TRS transform whose rotation is the slerp midpoint
+	e := ComposeTRS(Vec3{-1, 1, 4}, QuatSlerp(ra, rb, 0.5), Vec3{2, 2, 3})
+	r := Mat4BlendRigid(a, b, 0.5)
+	if !r.ApproxFuncEqual(e, absEqual(1e-4)) {
+		t.Errorf("Mat4BlendRigid(%v, %v, 0.5) != %v (got %v)", a, b, e, r)
+	}
+	if rot := r.RigidPart(); !rot.RotationEqual(QuatSlerp(ra, rb, 0.5).Mat4(), 1e-4) {
+		t.Errorf("Mat4BlendRigid midpoint rotation != %v (got %v)", QuatSlerp(ra, rb, 0.5).Mat4(), rot)
+	}
+}
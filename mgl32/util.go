@@ -21,6 +21,18 @@ import (
 // are being executed when you change this.
 var Epsilon float32 = 1e-10
 
+// machineEpsilon is the gap between 1 and the next larger float32, that is, the
+// relative precision of the type. Unlike Epsilon it is not a user-chosen threshold,
+// and it is used to tell rounding noise from meaningful values. It is computed rather
+// than written out so that it is also correct for float64.
+var machineEpsilon = func() float32 {
+	eps := float32(1)
+	for float32(1+eps/2) != 1 {
+		eps /= 2
+	}
+	return eps
+}()
+
 // A direct copy of the math package's Abs. This is here for the mgl32
 // package, to prevent rampant type conversions during equality tests.
 func Abs(a float32) float32 {
@@ -80,6 +92,15 @@ func FloatEqualThreshold(a, b, epsilon float32) bool {
 	return diff/(Abs(a)+Abs(b)) < epsilon
 }
 
+// diffOfProducts computes a*b - c*d using Kahan's algorithm: the rounding error of c*d
+// is recovered exactly with a fused multiply-add and added back in at the end.
+func diffOfProducts(a, b, c, d float32) float32 {
+	w := float64(c) * float64(d)
+	e := math.FMA(-float64(c), float64(d), w)
+	f := math.FMA(float64(a), float64(b), -w)
+	return float32(f + e)
+}
+
 // Clamp takes in a value and two thresholds. If the value is smaller than the low
 // threshold, it returns the low threshold. If it's bigger than the high threshold
 // it returns the high threshold. Otherwise it returns the value.
@@ -104,6 +125,12 @@ func ClampFunc(low, high float32) func(float32) float32 {
 	}
 }
 
+// Saturate clamps a to the range [0,1]. This is the same as Clamp(a, 0, 1),
+// and is named after the equivalent shading language function.
+func Saturate(a float32) float32 {
+	return Clamp(a, 0, 1)
+}
+
 /* The IsClamped functions use strict equality (meaning: not the FloatEqual function)
 there shouldn't be any major issues with this since clamp is often used to fix minor errors*/
 
@@ -12,6 +12,7 @@ package mgl64
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"text/tabwriter"
 
 	"golang.org/x/image/math/f64"
@@ -81,6 +82,31 @@ func (m Mat4) Mat3() Mat3 {
 	)
 }
 
+// ToArrayColMajor returns the elements of m in column major order, the layout OpenGL
+// expects. This is the same as the matrix's own storage.
+func (m Mat4) ToArrayColMajor() [16]float64 {
+	return [16]float64(m)
+}
+
+// ToArrayRowMajor returns the elements of m in row major order, as expected by C
+// libraries and graphics APIs (such as DirectX) that use that layout. Its elements are
+// those of ToArrayColMajor transposed.
+func (m Mat4) ToArrayRowMajor() [16]float64 {
+	return [16]float64(m.Transpose())
+}
+
+// Mat4FromArrayColMajor builds a matrix from 16 elements in column major order. It is
+// the inverse of Mat4.ToArrayColMajor.
+func Mat4FromArrayColMajor(a [16]float64) Mat4 {
+	return Mat4(a)
+}
+
+// Mat4FromArrayRowMajor builds a matrix from 16 elements in row major order. It is the
+// inverse of Mat4.ToArrayRowMajor.
+func Mat4FromArrayRowMajor(a [16]float64) Mat4 {
+	return Mat4(a).Transpose()
+}
+
 // Sets a Column within the Matrix, so it mutates the calling matrix.
 func (m *Mat2) SetCol(col int, v Vec2) {
 	m[col*2+0], m[col*2+1] = v[0], v[1]
@@ -97,6 +123,11 @@ func (m Mat2) Diag() Vec2 {
 	return Vec2{m[0], m[3]}
 }
 
+// Diagonal is an alias for Diag.
+func (m Mat2) Diagonal() Vec2 {
+	return m.Diag()
+}
+
 // Ident2 returns the 2x2 identity matrix.
 // The identity matrix is a square matrix with the value 1 on its
 // diagonals. The characteristic property of the identity matrix is that
@@ -117,13 +148,15 @@ func Diag2(v Vec2) Mat2 {
 
 // Mat2FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
-// good for hand-building matrices.
-
+// good for hand-building matrices, or when porting code that uses row major
+// conventions. Mat2FromRows(a, b, ...) is the transpose of Mat2FromCols(a, b, ...).
 func Mat2FromRows(row0, row1 Vec2) Mat2 {
 	return Mat2{row0[0], row1[0], row0[1], row1[1]}
 }
 
 // Mat2FromCols builds a new matrix from column vectors.
+// Since matrices are stored in column major order, this matches the underlying
+// storage exactly: the elements of col0 are the first 2 elements of the matrix, and so on.
 func Mat2FromCols(col0, col1 Vec2) Mat2 {
 	return Mat2{col0[0], col0[1], col1[0], col1[1]}
 }
@@ -146,6 +179,16 @@ func (m1 Mat2) Mul(c float64) Mat2 {
 	return Mat2{m1[0] * c, m1[1] * c, m1[2] * c, m1[3] * c}
 }
 
+// Lerp performs an element-wise linear interpolation between m1 and m2, returning m1
+// when t is 0 and m2 when t is 1.
+//
+// Note that interpolating transformation matrices this way does NOT generally produce
+// a valid rotation: the result can shear and shrink between the two inputs. To blend
+// rigid transforms, decompose them and interpolate the rotations with QuatSlerp instead.
+func (m1 Mat2) Lerp(m2 Mat2, t float64) Mat2 {
+	return Mat2{m1[0] + (m2[0]-m1[0])*t, m1[1] + (m2[1]-m1[1])*t, m1[2] + (m2[2]-m1[2])*t, m1[3] + (m2[3]-m1[3])*t}
+}
+
 // Mul2x1 performs a "matrix product" between this matrix
 // and another of the given dimension. For any two matrices of dimensionality
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
@@ -221,6 +264,18 @@ func (m Mat2) Det() float64 {
 	return m[0]*m[3] - m[1]*m[2]
 }
 
+// Adjugate returns the adjugate (classical adjoint) of a square matrix, the transpose of
+// its cofactor matrix. It satisfies
+//
+// M * adj(M) = adj(M) * M = det(M) * I
+//
+// so it's the inverse scaled by the determinant, but unlike the inverse it exists for
+// singular matrices too. This makes it handy for transforming normals, since the
+// inverse transpose is only needed up to scale.
+func (m Mat2) Adjugate() Mat2 {
+	return Mat2{m[3], -m[1], -m[2], m[0]}
+}
+
 // Inv computes the inverse of a square matrix. An inverse is a square matrix such that when multiplied by the
 // original, yields the identity.
 //
@@ -238,10 +293,7 @@ func (m Mat2) Inv() Mat2 {
 	if FloatEqual(det, float64(0.0)) {
 		return Mat2{}
 	}
-
-	retMat := Mat2{m[3], -m[1], -m[2], m[0]}
-
-	return retMat.Mul(1 / det)
+	return m.Adjugate().Mul(1 / det)
 }
 
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
@@ -344,6 +396,26 @@ func (m Mat2) Abs() Mat2 {
 	return Mat2{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3])}
 }
 
+// Norm returns the Frobenius norm of the matrix, the square root of the sum of the
+// squares of all its elements. This is the matrix equivalent of a vector's Len, and is
+// handy for checking convergence, e.g. m1.Sub(m2).Norm() < epsilon.
+func (m Mat2) Norm() float64 {
+	return float64(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3])))
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the absolute
+// values of the elements of any one row.
+func (m Mat2) NormInf() float64 {
+	var norm float64
+	for i := 0; i < 2; i++ {
+		sum := Abs(m[i+0]) + Abs(m[i+2])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Pretty prints the matrix
 func (m Mat2) String() string {
 	buf := new(bytes.Buffer)
@@ -372,13 +444,15 @@ func (m *Mat2x3) SetRow(row int, v Vec3) {
 
 // Mat2x3FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
-// good for hand-building matrices.
-
+// good for hand-building matrices, or when porting code that uses row major
+// conventions. Mat2x3FromRows(a, b, ...) is the transpose of Mat3x2FromCols(a, b, ...).
 func Mat2x3FromRows(row0, row1 Vec3) Mat2x3 {
 	return Mat2x3{row0[0], row1[0], row0[1], row1[1], row0[2], row1[2]}
 }
 
 // Mat2x3FromCols builds a new matrix from column vectors.
+// Since matrices are stored in column major order, this matches the underlying
+// storage exactly: the elements of col0 are the first 2 elements of the matrix, and so on.
 func Mat2x3FromCols(col0, col1, col2 Vec2) Mat2x3 {
 	return Mat2x3{col0[0], col0[1], col1[0], col1[1], col2[0], col2[1]}
 }
@@ -401,6 +475,16 @@ func (m1 Mat2x3) Mul(c float64) Mat2x3 {
 	return Mat2x3{m1[0] * c, m1[1] * c, m1[2] * c, m1[3] * c, m1[4] * c, m1[5] * c}
 }
 
+// Lerp performs an element-wise linear interpolation between m1 and m2, returning m1
+// when t is 0 and m2 when t is 1.
+//
+// Note that interpolating transformation matrices this way does NOT generally produce
+// a valid rotation: the result can shear and shrink between the two inputs. To blend
+// rigid transforms, decompose them and interpolate the rotations with QuatSlerp instead.
+func (m1 Mat2x3) Lerp(m2 Mat2x3, t float64) Mat2x3 {
+	return Mat2x3{m1[0] + (m2[0]-m1[0])*t, m1[1] + (m2[1]-m1[1])*t, m1[2] + (m2[2]-m1[2])*t, m1[3] + (m2[3]-m1[3])*t, m1[4] + (m2[4]-m1[4])*t, m1[5] + (m2[5]-m1[5])*t}
+}
+
 // Mul3x1 performs a "matrix product" between this matrix
 // and another of the given dimension. For any two matrices of dimensionality
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
@@ -562,6 +646,26 @@ func (m Mat2x3) Abs() Mat2x3 {
 	return Mat2x3{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5])}
 }
 
+// Norm returns the Frobenius norm of the matrix, the square root of the sum of the
+// squares of all its elements. This is the matrix equivalent of a vector's Len, and is
+// handy for checking convergence, e.g. m1.Sub(m2).Norm() < epsilon.
+func (m Mat2x3) Norm() float64 {
+	return float64(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5])))
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the absolute
+// values of the elements of any one row.
+func (m Mat2x3) NormInf() float64 {
+	var norm float64
+	for i := 0; i < 2; i++ {
+		sum := Abs(m[i+0]) + Abs(m[i+2]) + Abs(m[i+4])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Pretty prints the matrix
 func (m Mat2x3) String() string {
 	buf := new(bytes.Buffer)
@@ -590,13 +694,15 @@ func (m *Mat2x4) SetRow(row int, v Vec4) {
 
 // Mat2x4FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
-// good for hand-building matrices.
-
+// good for hand-building matrices, or when porting code that uses row major
+// conventions. Mat2x4FromRows(a, b, ...) is the transpose of Mat4x2FromCols(a, b, ...).
 func Mat2x4FromRows(row0, row1 Vec4) Mat2x4 {
 	return Mat2x4{row0[0], row1[0], row0[1], row1[1], row0[2], row1[2], row0[3], row1[3]}
 }
 
 // Mat2x4FromCols builds a new matrix from column vectors.
+// Since matrices are stored in column major order, this matches the underlying
+// storage exactly: the elements of col0 are the first 2 elements of the matrix, and so on.
 func Mat2x4FromCols(col0, col1, col2, col3 Vec2) Mat2x4 {
 	return Mat2x4{col0[0], col0[1], col1[0], col1[1], col2[0], col2[1], col3[0], col3[1]}
 }
@@ -619,6 +725,16 @@ func (m1 Mat2x4) Mul(c float64) Mat2x4 {
 	return Mat2x4{m1[0] * c, m1[1] * c, m1[2] * c, m1[3] * c, m1[4] * c, m1[5] * c, m1[6] * c, m1[7] * c}
 }
 
+// Lerp performs an element-wise linear interpolation between m1 and m2, returning m1
+// when t is 0 and m2 when t is 1.
+//
+// Note that interpolating transformation matrices this way does NOT generally produce
+// a valid rotation: the result can shear and shrink between the two inputs. To blend
+// rigid transforms, decompose them and interpolate the rotations with QuatSlerp instead.
+func (m1 Mat2x4) Lerp(m2 Mat2x4, t float64) Mat2x4 {
+	return Mat2x4{m1[0] + (m2[0]-m1[0])*t, m1[1] + (m2[1]-m1[1])*t, m1[2] + (m2[2]-m1[2])*t, m1[3] + (m2[3]-m1[3])*t, m1[4] + (m2[4]-m1[4])*t, m1[5] + (m2[5]-m1[5])*t, m1[6] + (m2[6]-m1[6])*t, m1[7] + (m2[7]-m1[7])*t}
+}
+
 // Mul4x1 performs a "matrix product" between this matrix
 // and another of the given dimension. For any two matrices of dimensionality
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
@@ -780,6 +896,26 @@ func (m Mat2x4) Abs() Mat2x4 {
 	return Mat2x4{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7])}
 }
 
+// Norm returns the Frobenius norm of the matrix, the square root of the sum of the
+// squares of all its elements. This is the matrix equivalent of a vector's Len, and is
+// handy for checking convergence, e.g. m1.Sub(m2).Norm() < epsilon.
+func (m Mat2x4) Norm() float64 {
+	return float64(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7])))
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the absolute
+// values of the elements of any one row.
+func (m Mat2x4) NormInf() float64 {
+	var norm float64
+	for i := 0; i < 2; i++ {
+		sum := Abs(m[i+0]) + Abs(m[i+2]) + Abs(m[i+4]) + Abs(m[i+6])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Pretty prints the matrix
 func (m Mat2x4) String() string {
 	buf := new(bytes.Buffer)
@@ -808,13 +944,15 @@ func (m *Mat3x2) SetRow(row int, v Vec2) {
 
 // Mat3x2FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
-// good for hand-building matrices.
-
+// good for hand-building matrices, or when porting code that uses row major
+// conventions. Mat3x2FromRows(a, b, ...) is the transpose of Mat2x3FromCols(a, b, ...).
 func Mat3x2FromRows(row0, row1, row2 Vec2) Mat3x2 {
 	return Mat3x2{row0[0], row1[0], row2[0], row0[1], row1[1], row2[1]}
 }
 
 // Mat3x2FromCols builds a new matrix from column vectors.
+// Since matrices are stored in column major order, this matches the underlying
+// storage exactly: the elements of col0 are the first 3 elements of the matrix, and so on.
 func Mat3x2FromCols(col0, col1 Vec3) Mat3x2 {
 	return Mat3x2{col0[0], col0[1], col0[2], col1[0], col1[1], col1[2]}
 }
@@ -837,6 +975,16 @@ func (m1 Mat3x2) Mul(c float64) Mat3x2 {
 	return Mat3x2{m1[0] * c, m1[1] * c, m1[2] * c, m1[3] * c, m1[4] * c, m1[5] * c}
 }
 
+// Lerp performs an element-wise linear interpolation between m1 and m2, returning m1
+// when t is 0 and m2 when t is 1.
+//
+// Note that interpolating transformation matrices this way does NOT generally produce
+// a valid rotation: the result can shear and shrink between the two inputs. To blend
+// rigid transforms, decompose them and interpolate the rotations with QuatSlerp instead.
+func (m1 Mat3x2) Lerp(m2 Mat3x2, t float64) Mat3x2 {
+	return Mat3x2{m1[0] + (m2[0]-m1[0])*t, m1[1] + (m2[1]-m1[1])*t, m1[2] + (m2[2]-m1[2])*t, m1[3] + (m2[3]-m1[3])*t, m1[4] + (m2[4]-m1[4])*t, m1[5] + (m2[5]-m1[5])*t}
+}
+
 // Mul2x1 performs a "matrix product" between this matrix
 // and another of the given dimension. For any two matrices of dimensionality
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
@@ -1008,6 +1156,26 @@ func (m Mat3x2) Abs() Mat3x2 {
 	return Mat3x2{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5])}
 }
 
+// Norm returns the Frobenius norm of the matrix, the square root of the sum of the
+// squares of all its elements. This is the matrix equivalent of a vector's Len, and is
+// handy for checking convergence, e.g. m1.Sub(m2).Norm() < epsilon.
+func (m Mat3x2) Norm() float64 {
+	return float64(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5])))
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the absolute
+// values of the elements of any one row.
+func (m Mat3x2) NormInf() float64 {
+	var norm float64
+	for i := 0; i < 3; i++ {
+		sum := Abs(m[i+0]) + Abs(m[i+3])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Pretty prints the matrix
 func (m Mat3x2) String() string {
 	buf := new(bytes.Buffer)
@@ -1040,6 +1208,11 @@ func (m Mat3) Diag() Vec3 {
 	return Vec3{m[0], m[4], m[8]}
 }
 
+// Diagonal is an alias for Diag.
+func (m Mat3) Diagonal() Vec3 {
+	return m.Diag()
+}
+
 // Ident3 returns the 3x3 identity matrix.
 // The identity matrix is a square matrix with the value 1 on its
 // diagonals. The characteristic property of the identity matrix is that
@@ -1060,13 +1233,15 @@ func Diag3(v Vec3) Mat3 {
 
 // Mat3FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
-// good for hand-building matrices.
-
+// good for hand-building matrices, or when porting code that uses row major
+// conventions. Mat3FromRows(a, b, ...) is the transpose of Mat3FromCols(a, b, ...).
 func Mat3FromRows(row0, row1, row2 Vec3) Mat3 {
 	return Mat3{row0[0], row1[0], row2[0], row0[1], row1[1], row2[1], row0[2], row1[2], row2[2]}
 }
 
 // Mat3FromCols builds a new matrix from column vectors.
+// Since matrices are stored in column major order, this matches the underlying
+// storage exactly: the elements of col0 are the first 3 elements of the matrix, and so on.
 func Mat3FromCols(col0, col1, col2 Vec3) Mat3 {
 	return Mat3{col0[0], col0[1], col0[2], col1[0], col1[1], col1[2], col2[0], col2[1], col2[2]}
 }
@@ -1089,6 +1264,16 @@ func (m1 Mat3) Mul(c float64) Mat3 {
 	return Mat3{m1[0] * c, m1[1] * c, m1[2] * c, m1[3] * c, m1[4] * c, m1[5] * c, m1[6] * c, m1[7] * c, m1[8] * c}
 }
 
+// Lerp performs an element-wise linear interpolation between m1 and m2, returning m1
+// when t is 0 and m2 when t is 1.
+//
+// Note that interpolating transformation matrices this way does NOT generally produce
+// a valid rotation: the result can shear and shrink between the two inputs. To blend
+// rigid transforms, decompose them and interpolate the rotations with QuatSlerp instead.
+func (m1 Mat3) Lerp(m2 Mat3, t float64) Mat3 {
+	return Mat3{m1[0] + (m2[0]-m1[0])*t, m1[1] + (m2[1]-m1[1])*t, m1[2] + (m2[2]-m1[2])*t, m1[3] + (m2[3]-m1[3])*t, m1[4] + (m2[4]-m1[4])*t, m1[5] + (m2[5]-m1[5])*t, m1[6] + (m2[6]-m1[6])*t, m1[7] + (m2[7]-m1[7])*t, m1[8] + (m2[8]-m1[8])*t}
+}
+
 // Mul3x1 performs a "matrix product" between this matrix
 // and another of the given dimension. For any two matrices of dimensionality
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
@@ -1174,6 +1359,28 @@ func (m Mat3) Det() float64 {
 	return m[0]*m[4]*m[8] + m[3]*m[7]*m[2] + m[6]*m[1]*m[5] - m[6]*m[4]*m[2] - m[3]*m[1]*m[8] - m[0]*m[7]*m[5]
 }
 
+// Adjugate returns the adjugate (classical adjoint) of a square matrix, the transpose of
+// its cofactor matrix. It satisfies
+//
+// M * adj(M) = adj(M) * M = det(M) * I
+//
+// so it's the inverse scaled by the determinant, but unlike the inverse it exists for
+// singular matrices too. This makes it handy for transforming normals, since the
+// inverse transpose is only needed up to scale.
+func (m Mat3) Adjugate() Mat3 {
+	return Mat3{
+		m[4]*m[8] - m[5]*m[7],
+		m[2]*m[7] - m[1]*m[8],
+		m[1]*m[5] - m[2]*m[4],
+		m[5]*m[6] - m[3]*m[8],
+		m[0]*m[8] - m[2]*m[6],
+		m[2]*m[3] - m[0]*m[5],
+		m[3]*m[7] - m[4]*m[6],
+		m[1]*m[6] - m[0]*m[7],
+		m[0]*m[4] - m[1]*m[3],
+	}
+}
+
 // Inv computes the inverse of a square matrix. An inverse is a square matrix such that when multiplied by the
 // original, yields the identity.
 //
@@ -1191,20 +1398,7 @@ func (m Mat3) Inv() Mat3 {
 	if FloatEqual(det, float64(0.0)) {
 		return Mat3{}
 	}
-
-	retMat := Mat3{
-		m[4]*m[8] - m[5]*m[7],
-		m[2]*m[7] - m[1]*m[8],
-		m[1]*m[5] - m[2]*m[4],
-		m[5]*m[6] - m[3]*m[8],
-		m[0]*m[8] - m[2]*m[6],
-		m[2]*m[3] - m[0]*m[5],
-		m[3]*m[7] - m[4]*m[6],
-		m[1]*m[6] - m[0]*m[7],
-		m[0]*m[4] - m[1]*m[3],
-	}
-
-	return retMat.Mul(1 / det)
+	return m.Adjugate().Mul(1 / det)
 }
 
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
@@ -1307,6 +1501,26 @@ func (m Mat3) Abs() Mat3 {
 	return Mat3{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7]), Abs(m[8])}
 }
 
+// Norm returns the Frobenius norm of the matrix, the square root of the sum of the
+// squares of all its elements. This is the matrix equivalent of a vector's Len, and is
+// handy for checking convergence, e.g. m1.Sub(m2).Norm() < epsilon.
+func (m Mat3) Norm() float64 {
+	return float64(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7] + m[8]*m[8])))
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the absolute
+// values of the elements of any one row.
+func (m Mat3) NormInf() float64 {
+	var norm float64
+	for i := 0; i < 3; i++ {
+		sum := Abs(m[i+0]) + Abs(m[i+3]) + Abs(m[i+6])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Pretty prints the matrix
 func (m Mat3) String() string {
 	buf := new(bytes.Buffer)
@@ -1335,13 +1549,15 @@ func (m *Mat3x4) SetRow(row int, v Vec4) {
 
 // Mat3x4FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
-// good for hand-building matrices.
-
+// good for hand-building matrices, or when porting code that uses row major
+// conventions. Mat3x4FromRows(a, b, ...) is the transpose of Mat4x3FromCols(a, b, ...).
 func Mat3x4FromRows(row0, row1, row2 Vec4) Mat3x4 {
 	return Mat3x4{row0[0], row1[0], row2[0], row0[1], row1[1], row2[1], row0[2], row1[2], row2[2], row0[3], row1[3], row2[3]}
 }
 
 // Mat3x4FromCols builds a new matrix from column vectors.
+// Since matrices are stored in column major order, this matches the underlying
+// storage exactly: the elements of col0 are the first 3 elements of the matrix, and so on.
 func Mat3x4FromCols(col0, col1, col2, col3 Vec3) Mat3x4 {
 	return Mat3x4{col0[0], col0[1], col0[2], col1[0], col1[1], col1[2], col2[0], col2[1], col2[2], col3[0], col3[1], col3[2]}
 }
@@ -1364,6 +1580,16 @@ func (m1 Mat3x4) Mul(c float64) Mat3x4 {
 	return Mat3x4{m1[0] * c, m1[1] * c, m1[2] * c, m1[3] * c, m1[4] * c, m1[5] * c, m1[6] * c, m1[7] * c, m1[8] * c, m1[9] * c, m1[10] * c, m1[11] * c}
 }
 
+// Lerp performs an element-wise linear interpolation between m1 and m2, returning m1
+// when t is 0 and m2 when t is 1.
+//
+// Note that interpolating transformation matrices this way does NOT generally produce
+// a valid rotation: the result can shear and shrink between the two inputs. To blend
+// rigid transforms, decompose them and interpolate the rotations with QuatSlerp instead.
+func (m1 Mat3x4) Lerp(m2 Mat3x4, t float64) Mat3x4 {
+	return Mat3x4{m1[0] + (m2[0]-m1[0])*t, m1[1] + (m2[1]-m1[1])*t, m1[2] + (m2[2]-m1[2])*t, m1[3] + (m2[3]-m1[3])*t, m1[4] + (m2[4]-m1[4])*t, m1[5] + (m2[5]-m1[5])*t, m1[6] + (m2[6]-m1[6])*t, m1[7] + (m2[7]-m1[7])*t, m1[8] + (m2[8]-m1[8])*t, m1[9] + (m2[9]-m1[9])*t, m1[10] + (m2[10]-m1[10])*t, m1[11] + (m2[11]-m1[11])*t}
+}
+
 // Mul4x1 performs a "matrix product" between this matrix
 // and another of the given dimension. For any two matrices of dimensionality
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
@@ -1535,6 +1761,26 @@ func (m Mat3x4) Abs() Mat3x4 {
 	return Mat3x4{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7]), Abs(m[8]), Abs(m[9]), Abs(m[10]), Abs(m[11])}
 }
 
+// Norm returns the Frobenius norm of the matrix, the square root of the sum of the
+// squares of all its elements. This is the matrix equivalent of a vector's Len, and is
+// handy for checking convergence, e.g. m1.Sub(m2).Norm() < epsilon.
+func (m Mat3x4) Norm() float64 {
+	return float64(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7] + m[8]*m[8] + m[9]*m[9] + m[10]*m[10] + m[11]*m[11])))
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the absolute
+// values of the elements of any one row.
+func (m Mat3x4) NormInf() float64 {
+	var norm float64
+	for i := 0; i < 3; i++ {
+		sum := Abs(m[i+0]) + Abs(m[i+3]) + Abs(m[i+6]) + Abs(m[i+9])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Pretty prints the matrix
 func (m Mat3x4) String() string {
 	buf := new(bytes.Buffer)
@@ -1563,13 +1809,15 @@ func (m *Mat4x2) SetRow(row int, v Vec2) {
 
 // Mat4x2FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
-// good for hand-building matrices.
-
+// good for hand-building matrices, or when porting code that uses row major
+// conventions. Mat4x2FromRows(a, b, ...) is the transpose of Mat2x4FromCols(a, b, ...).
 func Mat4x2FromRows(row0, row1, row2, row3 Vec2) Mat4x2 {
 	return Mat4x2{row0[0], row1[0], row2[0], row3[0], row0[1], row1[1], row2[1], row3[1]}
 }
 
 // Mat4x2FromCols builds a new matrix from column vectors.
+// Since matrices are stored in column major order, this matches the underlying
+// storage exactly: the elements of col0 are the first 4 elements of the matrix, and so on.
 func Mat4x2FromCols(col0, col1 Vec4) Mat4x2 {
 	return Mat4x2{col0[0], col0[1], col0[2], col0[3], col1[0], col1[1], col1[2], col1[3]}
 }
@@ -1592,6 +1840,16 @@ func (m1 Mat4x2) Mul(c float64) Mat4x2 {
 	return Mat4x2{m1[0] * c, m1[1] * c, m1[2] * c, m1[3] * c, m1[4] * c, m1[5] * c, m1[6] * c, m1[7] * c}
 }
 
+// Lerp performs an element-wise linear interpolation between m1 and m2, returning m1
+// when t is 0 and m2 when t is 1.
+//
+// Note that interpolating transformation matrices this way does NOT generally produce
+// a valid rotation: the result can shear and shrink between the two inputs. To blend
+// rigid transforms, decompose them and interpolate the rotations with QuatSlerp instead.
+func (m1 Mat4x2) Lerp(m2 Mat4x2, t float64) Mat4x2 {
+	return Mat4x2{m1[0] + (m2[0]-m1[0])*t, m1[1] + (m2[1]-m1[1])*t, m1[2] + (m2[2]-m1[2])*t, m1[3] + (m2[3]-m1[3])*t, m1[4] + (m2[4]-m1[4])*t, m1[5] + (m2[5]-m1[5])*t, m1[6] + (m2[6]-m1[6])*t, m1[7] + (m2[7]-m1[7])*t}
+}
+
 // Mul2x1 performs a "matrix product" between this matrix
 // and another of the given dimension. For any two matrices of dimensionality
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
@@ -1773,6 +2031,26 @@ func (m Mat4x2) Abs() Mat4x2 {
 	return Mat4x2{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7])}
 }
 
+// Norm returns the Frobenius norm of the matrix, the square root of the sum of the
+// squares of all its elements. This is the matrix equivalent of a vector's Len, and is
+// handy for checking convergence, e.g. m1.Sub(m2).Norm() < epsilon.
+func (m Mat4x2) Norm() float64 {
+	return float64(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7])))
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the absolute
+// values of the elements of any one row.
+func (m Mat4x2) NormInf() float64 {
+	var norm float64
+	for i := 0; i < 4; i++ {
+		sum := Abs(m[i+0]) + Abs(m[i+4])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Pretty prints the matrix
 func (m Mat4x2) String() string {
 	buf := new(bytes.Buffer)
@@ -1801,13 +2079,15 @@ func (m *Mat4x3) SetRow(row int, v Vec3) {
 
 // Mat4x3FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
-// good for hand-building matrices.
-
+// good for hand-building matrices, or when porting code that uses row major
+// conventions. Mat4x3FromRows(a, b, ...) is the transpose of Mat3x4FromCols(a, b, ...).
 func Mat4x3FromRows(row0, row1, row2, row3 Vec3) Mat4x3 {
 	return Mat4x3{row0[0], row1[0], row2[0], row3[0], row0[1], row1[1], row2[1], row3[1], row0[2], row1[2], row2[2], row3[2]}
 }
 
 // Mat4x3FromCols builds a new matrix from column vectors.
+// Since matrices are stored in column major order, this matches the underlying
+// storage exactly: the elements of col0 are the first 4 elements of the matrix, and so on.
 func Mat4x3FromCols(col0, col1, col2 Vec4) Mat4x3 {
 	return Mat4x3{col0[0], col0[1], col0[2], col0[3], col1[0], col1[1], col1[2], col1[3], col2[0], col2[1], col2[2], col2[3]}
 }
@@ -1830,6 +2110,16 @@ func (m1 Mat4x3) Mul(c float64) Mat4x3 {
 	return Mat4x3{m1[0] * c, m1[1] * c, m1[2] * c, m1[3] * c, m1[4] * c, m1[5] * c, m1[6] * c, m1[7] * c, m1[8] * c, m1[9] * c, m1[10] * c, m1[11] * c}
 }
 
+// Lerp performs an element-wise linear interpolation between m1 and m2, returning m1
+// when t is 0 and m2 when t is 1.
+//
+// Note that interpolating transformation matrices this way does NOT generally produce
+// a valid rotation: the result can shear and shrink between the two inputs. To blend
+// rigid transforms, decompose them and interpolate the rotations with QuatSlerp instead.
+func (m1 Mat4x3) Lerp(m2 Mat4x3, t float64) Mat4x3 {
+	return Mat4x3{m1[0] + (m2[0]-m1[0])*t, m1[1] + (m2[1]-m1[1])*t, m1[2] + (m2[2]-m1[2])*t, m1[3] + (m2[3]-m1[3])*t, m1[4] + (m2[4]-m1[4])*t, m1[5] + (m2[5]-m1[5])*t, m1[6] + (m2[6]-m1[6])*t, m1[7] + (m2[7]-m1[7])*t, m1[8] + (m2[8]-m1[8])*t, m1[9] + (m2[9]-m1[9])*t, m1[10] + (m2[10]-m1[10])*t, m1[11] + (m2[11]-m1[11])*t}
+}
+
 // Mul3x1 performs a "matrix product" between this matrix
 // and another of the given dimension. For any two matrices of dimensionality
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
@@ -2011,6 +2301,26 @@ func (m Mat4x3) Abs() Mat4x3 {
 	return Mat4x3{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7]), Abs(m[8]), Abs(m[9]), Abs(m[10]), Abs(m[11])}
 }
 
+// Norm returns the Frobenius norm of the matrix, the square root of the sum of the
+// squares of all its elements. This is the matrix equivalent of a vector's Len, and is
+// handy for checking convergence, e.g. m1.Sub(m2).Norm() < epsilon.
+func (m Mat4x3) Norm() float64 {
+	return float64(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7] + m[8]*m[8] + m[9]*m[9] + m[10]*m[10] + m[11]*m[11])))
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the absolute
+// values of the elements of any one row.
+func (m Mat4x3) NormInf() float64 {
+	var norm float64
+	for i := 0; i < 4; i++ {
+		sum := Abs(m[i+0]) + Abs(m[i+4]) + Abs(m[i+8])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Pretty prints the matrix
 func (m Mat4x3) String() string {
 	buf := new(bytes.Buffer)
@@ -2043,6 +2353,11 @@ func (m Mat4) Diag() Vec4 {
 	return Vec4{m[0], m[5], m[10], m[15]}
 }
 
+// Diagonal is an alias for Diag.
+func (m Mat4) Diagonal() Vec4 {
+	return m.Diag()
+}
+
 // Ident4 returns the 4x4 identity matrix.
 // The identity matrix is a square matrix with the value 1 on its
 // diagonals. The characteristic property of the identity matrix is that
@@ -2063,13 +2378,15 @@ func Diag4(v Vec4) Mat4 {
 
 // Mat4FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
-// good for hand-building matrices.
-
+// good for hand-building matrices, or when porting code that uses row major
+// conventions. Mat4FromRows(a, b, ...) is the transpose of Mat4FromCols(a, b, ...).
 func Mat4FromRows(row0, row1, row2, row3 Vec4) Mat4 {
 	return Mat4{row0[0], row1[0], row2[0], row3[0], row0[1], row1[1], row2[1], row3[1], row0[2], row1[2], row2[2], row3[2], row0[3], row1[3], row2[3], row3[3]}
 }
 
 // Mat4FromCols builds a new matrix from column vectors.
+// Since matrices are stored in column major order, this matches the underlying
+// storage exactly: the elements of col0 are the first 4 elements of the matrix, and so on.
 func Mat4FromCols(col0, col1, col2, col3 Vec4) Mat4 {
 	return Mat4{col0[0], col0[1], col0[2], col0[3], col1[0], col1[1], col1[2], col1[3], col2[0], col2[1], col2[2], col2[3], col3[0], col3[1], col3[2], col3[3]}
 }
@@ -2092,6 +2409,16 @@ func (m1 Mat4) Mul(c float64) Mat4 {
 	return Mat4{m1[0] * c, m1[1] * c, m1[2] * c, m1[3] * c, m1[4] * c, m1[5] * c, m1[6] * c, m1[7] * c, m1[8] * c, m1[9] * c, m1[10] * c, m1[11] * c, m1[12] * c, m1[13] * c, m1[14] * c, m1[15] * c}
 }
 
+// Lerp performs an element-wise linear interpolation between m1 and m2, returning m1
+// when t is 0 and m2 when t is 1.
+//
+// Note that interpolating transformation matrices this way does NOT generally produce
+// a valid rotation: the result can shear and shrink between the two inputs. To blend
+// rigid transforms, decompose them and interpolate the rotations with QuatSlerp instead.
+func (m1 Mat4) Lerp(m2 Mat4, t float64) Mat4 {
+	return Mat4{m1[0] + (m2[0]-m1[0])*t, m1[1] + (m2[1]-m1[1])*t, m1[2] + (m2[2]-m1[2])*t, m1[3] + (m2[3]-m1[3])*t, m1[4] + (m2[4]-m1[4])*t, m1[5] + (m2[5]-m1[5])*t, m1[6] + (m2[6]-m1[6])*t, m1[7] + (m2[7]-m1[7])*t, m1[8] + (m2[8]-m1[8])*t, m1[9] + (m2[9]-m1[9])*t, m1[10] + (m2[10]-m1[10])*t, m1[11] + (m2[11]-m1[11])*t, m1[12] + (m2[12]-m1[12])*t, m1[13] + (m2[13]-m1[13])*t, m1[14] + (m2[14]-m1[14])*t, m1[15] + (m2[15]-m1[15])*t}
+}
+
 // Mul4x1 performs a "matrix product" between this matrix
 // and another of the given dimension. For any two matrices of dimensionality
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
@@ -2147,24 +2474,42 @@ func (m1 Mat4) Mul4x3(m2 Mat4x3) Mat4x3 {
 // and another of the given dimension. For any two matrices of dimensionality
 // MxN and NxO, the result will be MxO. For instance, Mat4 multiplied using
 // Mul4x2 will result in a Mat4x2.
+//
+// Building with the mglsimd tag on amd64 replaces this with an SSE implementation
+// that gives identical results.
 func (m1 Mat4) Mul4(m2 Mat4) Mat4 {
+	return mul4(m1, m2)
+}
+
+// Mul4Into stores the product a*b in dst, avoiding the copy of the returned
+// matrix that a.Mul4(b) involves in tight loops. Since a and b are passed by
+// value, dst may point at either of them (e.g. Mul4Into(&m, m, n)).
+func Mul4Into(dst *Mat4, a, b Mat4) {
+	mul4Into(dst, &a, &b)
+}
+
+// mul4Generic is the pure Go implementation of Mat4.Mul4. Each product is
+// explicitly converted to float32, which forces it to be rounded, so the compiler
+// can't fuse it with the following addition into an FMA instruction (as it may
+// when targeting newer CPUs). This keeps the result identical to the SSE version.
+func mul4Generic(m1, m2 Mat4) Mat4 {
 	return Mat4{
-		m1[0]*m2[0] + m1[4]*m2[1] + m1[8]*m2[2] + m1[12]*m2[3],
-		m1[1]*m2[0] + m1[5]*m2[1] + m1[9]*m2[2] + m1[13]*m2[3],
-		m1[2]*m2[0] + m1[6]*m2[1] + m1[10]*m2[2] + m1[14]*m2[3],
-		m1[3]*m2[0] + m1[7]*m2[1] + m1[11]*m2[2] + m1[15]*m2[3],
-		m1[0]*m2[4] + m1[4]*m2[5] + m1[8]*m2[6] + m1[12]*m2[7],
-		m1[1]*m2[4] + m1[5]*m2[5] + m1[9]*m2[6] + m1[13]*m2[7],
-		m1[2]*m2[4] + m1[6]*m2[5] + m1[10]*m2[6] + m1[14]*m2[7],
-		m1[3]*m2[4] + m1[7]*m2[5] + m1[11]*m2[6] + m1[15]*m2[7],
-		m1[0]*m2[8] + m1[4]*m2[9] + m1[8]*m2[10] + m1[12]*m2[11],
-		m1[1]*m2[8] + m1[5]*m2[9] + m1[9]*m2[10] + m1[13]*m2[11],
-		m1[2]*m2[8] + m1[6]*m2[9] + m1[10]*m2[10] + m1[14]*m2[11],
-		m1[3]*m2[8] + m1[7]*m2[9] + m1[11]*m2[10] + m1[15]*m2[11],
-		m1[0]*m2[12] + m1[4]*m2[13] + m1[8]*m2[14] + m1[12]*m2[15],
-		m1[1]*m2[12] + m1[5]*m2[13] + m1[9]*m2[14] + m1[13]*m2[15],
-		m1[2]*m2[12] + m1[6]*m2[13] + m1[10]*m2[14] + m1[14]*m2[15],
-		m1[3]*m2[12] + m1[7]*m2[13] + m1[11]*m2[14] + m1[15]*m2[15],
+		float64(m1[0]*m2[0]) + float64(m1[4]*m2[1]) + float64(m1[8]*m2[2]) + float64(m1[12]*m2[3]),
+		float64(m1[1]*m2[0]) + float64(m1[5]*m2[1]) + float64(m1[9]*m2[2]) + float64(m1[13]*m2[3]),
+		float64(m1[2]*m2[0]) + float64(m1[6]*m2[1]) + float64(m1[10]*m2[2]) + float64(m1[14]*m2[3]),
+		float64(m1[3]*m2[0]) + float64(m1[7]*m2[1]) + float64(m1[11]*m2[2]) + float64(m1[15]*m2[3]),
+		float64(m1[0]*m2[4]) + float64(m1[4]*m2[5]) + float64(m1[8]*m2[6]) + float64(m1[12]*m2[7]),
+		float64(m1[1]*m2[4]) + float64(m1[5]*m2[5]) + float64(m1[9]*m2[6]) + float64(m1[13]*m2[7]),
+		float64(m1[2]*m2[4]) + float64(m1[6]*m2[5]) + float64(m1[10]*m2[6]) + float64(m1[14]*m2[7]),
+		float64(m1[3]*m2[4]) + float64(m1[7]*m2[5]) + float64(m1[11]*m2[6]) + float64(m1[15]*m2[7]),
+		float64(m1[0]*m2[8]) + float64(m1[4]*m2[9]) + float64(m1[8]*m2[10]) + float64(m1[12]*m2[11]),
+		float64(m1[1]*m2[8]) + float64(m1[5]*m2[9]) + float64(m1[9]*m2[10]) + float64(m1[13]*m2[11]),
+		float64(m1[2]*m2[8]) + float64(m1[6]*m2[9]) + float64(m1[10]*m2[10]) + float64(m1[14]*m2[11]),
+		float64(m1[3]*m2[8]) + float64(m1[7]*m2[9]) + float64(m1[11]*m2[10]) + float64(m1[15]*m2[11]),
+		float64(m1[0]*m2[12]) + float64(m1[4]*m2[13]) + float64(m1[8]*m2[14]) + float64(m1[12]*m2[15]),
+		float64(m1[1]*m2[12]) + float64(m1[5]*m2[13]) + float64(m1[9]*m2[14]) + float64(m1[13]*m2[15]),
+		float64(m1[2]*m2[12]) + float64(m1[6]*m2[13]) + float64(m1[10]*m2[14]) + float64(m1[14]*m2[15]),
+		float64(m1[3]*m2[12]) + float64(m1[7]*m2[13]) + float64(m1[11]*m2[14]) + float64(m1[15]*m2[15]),
 	}
 }
 
@@ -2187,25 +2532,16 @@ func (m Mat4) Det() float64 {
 	return m[0]*m[5]*m[10]*m[15] - m[0]*m[5]*m[11]*m[14] - m[0]*m[6]*m[9]*m[15] + m[0]*m[6]*m[11]*m[13] + m[0]*m[7]*m[9]*m[14] - m[0]*m[7]*m[10]*m[13] - m[1]*m[4]*m[10]*m[15] + m[1]*m[4]*m[11]*m[14] + m[1]*m[6]*m[8]*m[15] - m[1]*m[6]*m[11]*m[12] - m[1]*m[7]*m[8]*m[14] + m[1]*m[7]*m[10]*m[12] + m[2]*m[4]*m[9]*m[15] - m[2]*m[4]*m[11]*m[13] - m[2]*m[5]*m[8]*m[15] + m[2]*m[5]*m[11]*m[12] + m[2]*m[7]*m[8]*m[13] - m[2]*m[7]*m[9]*m[12] - m[3]*m[4]*m[9]*m[14] + m[3]*m[4]*m[10]*m[13] + m[3]*m[5]*m[8]*m[14] - m[3]*m[5]*m[10]*m[12] - m[3]*m[6]*m[8]*m[13] + m[3]*m[6]*m[9]*m[12]
 }
 
-// Inv computes the inverse of a square matrix. An inverse is a square matrix such that when multiplied by the
-// original, yields the identity.
-//
-// M_inv * M = M * M_inv = I
+// Adjugate returns the adjugate (classical adjoint) of a square matrix, the transpose of
+// its cofactor matrix. It satisfies
 //
-// In this library, the math is precomputed, and uses no loops, though the multiplications, additions, determinant calculation, and scaling
-// are still done. This can still be (relatively) expensive for a 4x4.
+// M * adj(M) = adj(M) * M = det(M) * I
 //
-// This function checks the determinant to see if the matrix is invertible.
-// If the determinant is 0.0, this function returns the zero matrix. However, due to floating point errors, it is
-// entirely plausible to get a false positive or negative.
-// In the future, an alternate function may be written which takes in a pre-computed determinant.
-func (m Mat4) Inv() Mat4 {
-	det := m.Det()
-	if FloatEqual(det, float64(0.0)) {
-		return Mat4{}
-	}
-
-	retMat := Mat4{
+// so it's the inverse scaled by the determinant, but unlike the inverse it exists for
+// singular matrices too. This makes it handy for transforming normals, since the
+// inverse transpose is only needed up to scale.
+func (m Mat4) Adjugate() Mat4 {
+	return Mat4{
 		-m[7]*m[10]*m[13] + m[6]*m[11]*m[13] + m[7]*m[9]*m[14] - m[5]*m[11]*m[14] - m[6]*m[9]*m[15] + m[5]*m[10]*m[15],
 		m[3]*m[10]*m[13] - m[2]*m[11]*m[13] - m[3]*m[9]*m[14] + m[1]*m[11]*m[14] + m[2]*m[9]*m[15] - m[1]*m[10]*m[15],
 		-m[3]*m[6]*m[13] + m[2]*m[7]*m[13] + m[3]*m[5]*m[14] - m[1]*m[7]*m[14] - m[2]*m[5]*m[15] + m[1]*m[6]*m[15],
@@ -2223,8 +2559,26 @@ func (m Mat4) Inv() Mat4 {
 		m[2]*m[5]*m[12] - m[1]*m[6]*m[12] - m[2]*m[4]*m[13] + m[0]*m[6]*m[13] + m[1]*m[4]*m[14] - m[0]*m[5]*m[14],
 		-m[2]*m[5]*m[8] + m[1]*m[6]*m[8] + m[2]*m[4]*m[9] - m[0]*m[6]*m[9] - m[1]*m[4]*m[10] + m[0]*m[5]*m[10],
 	}
+}
 
-	return retMat.Mul(1 / det)
+// Inv computes the inverse of a square matrix. An inverse is a square matrix such that when multiplied by the
+// original, yields the identity.
+//
+// M_inv * M = M * M_inv = I
+//
+// In this library, the math is precomputed, and uses no loops, though the multiplications, additions, determinant calculation, and scaling
+// are still done. This can still be (relatively) expensive for a 4x4.
+//
+// This function checks the determinant to see if the matrix is invertible.
+// If the determinant is 0.0, this function returns the zero matrix. However, due to floating point errors, it is
+// entirely plausible to get a false positive or negative.
+// In the future, an alternate function may be written which takes in a pre-computed determinant.
+func (m Mat4) Inv() Mat4 {
+	det := m.Det()
+	if FloatEqual(det, float64(0.0)) {
+		return Mat4{}
+	}
+	return m.Adjugate().Mul(1 / det)
 }
 
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
@@ -2327,6 +2681,26 @@ func (m Mat4) Abs() Mat4 {
 	return Mat4{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7]), Abs(m[8]), Abs(m[9]), Abs(m[10]), Abs(m[11]), Abs(m[12]), Abs(m[13]), Abs(m[14]), Abs(m[15])}
 }
 
+// Norm returns the Frobenius norm of the matrix, the square root of the sum of the
+// squares of all its elements. This is the matrix equivalent of a vector's Len, and is
+// handy for checking convergence, e.g. m1.Sub(m2).Norm() < epsilon.
+func (m Mat4) Norm() float64 {
+	return float64(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7] + m[8]*m[8] + m[9]*m[9] + m[10]*m[10] + m[11]*m[11] + m[12]*m[12] + m[13]*m[13] + m[14]*m[14] + m[15]*m[15])))
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the absolute
+// values of the elements of any one row.
+func (m Mat4) NormInf() float64 {
+	var norm float64
+	for i := 0; i < 4; i++ {
+		sum := Abs(m[i+0]) + Abs(m[i+4]) + Abs(m[i+8]) + Abs(m[i+12])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Pretty prints the matrix
 func (m Mat4) String() string {
 	buf := new(bytes.Buffer)
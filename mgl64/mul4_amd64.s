@@ -0,0 +1,58 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build mglsimd
+// +build mglsimd
+
+#include "textflag.h"
+
+// This file is not generated from mgl32: each column is 32 bytes, so it is
+// handled as two halves of two float64 lanes each.
+
+// HALF computes rows row..row+1 of column off/32 of the product into X8,
+// using X9 as scratch, then stores them into dst. The columns of a are held
+// in X0-X7 as (lo, hi) pairs.
+#define HALF(off, row, c0, c1, c2, c3) \
+	MOVSD    (off+0)(DX), X8  \
+	UNPCKLPD X8, X8           \
+	MULPD    c0, X8           \
+	MOVSD    (off+8)(DX), X9  \
+	UNPCKLPD X9, X9           \
+	MULPD    c1, X9           \
+	ADDPD    X9, X8           \
+	MOVSD    (off+16)(DX), X9 \
+	UNPCKLPD X9, X9           \
+	MULPD    c2, X9           \
+	ADDPD    X9, X8           \
+	MOVSD    (off+24)(DX), X9 \
+	UNPCKLPD X9, X9           \
+	MULPD    c3, X9           \
+	ADDPD    X9, X8           \
+	MOVUPD   X8, (off+row*8)(DI)
+
+#define COLUMN(off) \
+	HALF(off, 0, X0, X2, X4, X6) \
+	HALF(off, 2, X1, X3, X5, X7)
+
+// func mul4SSE(dst, a, b *Mat4)
+TEXT ·mul4SSE(SB), NOSPLIT, $0-24
+	MOVQ dst+0(FP), DI
+	MOVQ a+8(FP), SI
+	MOVQ b+16(FP), DX
+
+	MOVUPD 0(SI), X0
+	MOVUPD 16(SI), X1
+	MOVUPD 32(SI), X2
+	MOVUPD 48(SI), X3
+	MOVUPD 64(SI), X4
+	MOVUPD 80(SI), X5
+	MOVUPD 96(SI), X6
+	MOVUPD 112(SI), X7
+
+	COLUMN(0)
+	COLUMN(32)
+	COLUMN(64)
+	COLUMN(96)
+
+	RET
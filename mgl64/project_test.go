@@ -175,6 +175,29 @@ func TestOrtho(t *testing.T) {
 	}
 }
 
+func TestOrthoCentered(t *testing.T) {
+	tests := []struct {
+		Width, Height, Near, Far float64
+	}{
+		{2, 2, -1, 1},
+		{20, 10, 0.1, 100},
+		{1920, 1080, 0, 1},
+	}
+
+	for _, c := range tests {
+		e := Ortho(-c.Width/2, c.Width/2, -c.Height/2, c.Height/2, c.Near, c.Far)
+		if r := OrthoCentered(c.Width, c.Height, c.Near, c.Far); r != e {
+			t.Errorf("OrthoCentered(%v, %v, %v, %v) != %v (got %v)", c.Width, c.Height, c.Near, c.Far, e, r)
+		}
+	}
+
+	// The corners of the box map to the corners of clip space
+	m := OrthoCentered(20, 10, 1, 5)
+	if r, e := m.Mul4x1(Vec4{10, 5, -1, 1}), (Vec4{1, 1, -1, 1}); !r.EqualThreshold(e, 1e-6) {
+		t.Errorf("OrthoCentered(20, 10, 1, 5) maps the near corner to %v, expected %v", r, e)
+	}
+}
+
 func TestOrtho2D(t *testing.T) {
 	tests := []struct {
 		Left, Right,
@@ -225,6 +248,44 @@ func TestPerspective(t *testing.T) {
 	}
 }
 
+func TestPerspectiveParams(t *testing.T) {
+	tests := []struct {
+		Fovy, Aspect,
+		Near, Far float64
+	}{
+		{DegToRad(45.0), 4.0 / 3.0, 0.1, 100.0},
+		{DegToRad(90.0), 16.0 / 9.0, 1, 10},
+		{DegToRad(60.0), 1, 0.5, 2000},
+	}
+
+	for _, c := range tests {
+		m := Perspective(c.Fovy, c.Aspect, c.Near, c.Far)
+		fovy, aspect, near, far, ok := m.PerspectiveParams()
+		if !ok {
+			t.Errorf("Perspective(%v, %v, %v, %v).PerspectiveParams() did not recognize perspective matrix", c.Fovy, c.Aspect, c.Near, c.Far)
+			continue
+		}
+
+		eq := FloatEqualFunc(1e-3)
+		if !eq(fovy, c.Fovy) || !eq(aspect, c.Aspect) || !eq(near, c.Near) || !eq(far, c.Far) {
+			t.Errorf("Perspective(%v, %v, %v, %v).PerspectiveParams() returned (%v, %v, %v, %v)", c.Fovy, c.Aspect, c.Near, c.Far, fovy, aspect, near, far)
+		}
+	}
+
+	notPerspective := []Mat4{
+		Ident4(),
+		Ortho(-1, 1, -1, 1, 0.1, 100),
+		Frustum(-1, 2, -1, 1, 1, 10),
+		LookAtV(Vec3{0, 0, 10}, Vec3{0, 0, 0}, Vec3{0, 1, 0}),
+	}
+
+	for _, m := range notPerspective {
+		if _, _, _, _, ok := m.PerspectiveParams(); ok {
+			t.Errorf("Mat4(%v).PerspectiveParams() recognized non-perspective matrix", m)
+		}
+	}
+}
+
 func TestFrustum(t *testing.T) {
 	tests := []struct {
 		Left, Right,
@@ -245,3 +306,193 @@ func TestFrustum(t *testing.T) {
 		}
 	}
 }
+
+func TestShadowMatrix(t *testing.T) {
+	ground := Vec4{0, 1, 0, 0}
+	tests := []struct {
+		Description string
+		Plane       Vec4
+		Light       Vec4
+		Point       Vec3
+		Expected    Vec3
+	}{
+		{
+			"point light above origin",
+			ground, Vec4{0, 10, 0, 1},
+			Vec3{2, 5, 0},
+			Vec3{4, 0, 0},
+		},
+		{
+			"point light off axis",
+			ground, Vec4{1, 4, 1, 1},
+			Vec3{1, 2, 3},
+			Vec3{1, 0, 5},
+		},
+		{
+			"directional light",
+			ground, Vec4{1, 1, 0, 0},
+			Vec3{0, 5, 0},
+			Vec3{-5, 0, 0},
+		},
+		{
+			"offset plane y=1",
+			Vec4{0, 1, 0, -1}, Vec4{0, 1, 0, 0},
+			Vec3{3, 7, -2},
+			Vec3{3, 1, -2},
+		},
+	}
+
+	for _, c := range tests {
+		m := ShadowMatrix(c.Plane, c.Light)
+		r := TransformCoordinate(c.Point, m)
+		if !r.ApproxEqualThreshold(c.Expected, 1e-4) {
+			t.Errorf("%v failed: ShadowMatrix(%v, %v) applied to %v != %v (got %v)", c.Description, c.Plane, c.Light, c.Point, c.Expected, r)
+		}
+		if dist := c.Plane.Dot(r.Vec4(1)); !FloatEqualThreshold(dist, 0, 1e-4) {
+			t.Errorf("%v failed: projected point %v does not lie on plane %v", c.Description, r, c.Plane)
+		}
+	}
+}
+
+func TestViewportMatrix(t *testing.T) {
+	t.Parallel()
+
+	m := ViewportMatrix(10, 20, 640, 480)
+
+	tests := []struct {
+		NDC, Expected Vec3
+	}{
+		{Vec3{-1, -1, -1}, Vec3{10, 20, 0}},
+		{Vec3{1, 1, 1}, Vec3{650, 500, 1}},
+		{Vec3{0, 0, 0}, Vec3{330, 260, 0.5}},
+	}
+
+	for _, c := range tests {
+		if r := m.Mul4x1(c.NDC.Vec4(1)).Vec3(); !r.EqualThreshold(c.Expected, 1e-4) {
+			t.Errorf("ViewportMatrix * %v != %v (got %v)", c.NDC, c.Expected, r)
+		}
+	}
+
+	// Should agree with Project
+	obj := Vec3{0.3, -0.2, 0.5}
+	projection := Perspective(DegToRad(60), 640.0/480, 0.1, 100)
+	win := Project(obj, Ident4(), projection, 10, 20, 640, 480)
+	if r := TransformCoordinate(TransformCoordinate(obj, projection), m); !r.EqualThreshold(win, 1e-3) {
+		t.Errorf("ViewportMatrix disagrees with Project: expected %v, got %v", win, r)
+	}
+}
+
+func TestCubeMapView(t *testing.T) {
+	t.Parallel()
+
+	pos := Vec3{1, 2, 3}
+	forwards := []Vec3{{1, 0, 0}, {-1, 0, 0}, {0, 1, 0}, {0, -1, 0}, {0, 0, 1}, {0, 0, -1}}
+	ups := []Vec3{{0, -1, 0}, {0, -1, 0}, {0, 0, 1}, {0, 0, -1}, {0, -1, 0}, {0, -1, 0}}
+
+	for face := range forwards {
+		m := CubeMapView(face, pos)
+
+		// The view looks down -Z in eye space, with +Y up
+		if r := TransformNormal(forwards[face], m); !r.EqualThreshold(Vec3{0, 0, -1}, 1e-6) {
+			t.Errorf("CubeMapView(%v) forward %v maps to %v, not -Z", face, forwards[face], r)
+		}
+		if r := TransformNormal(ups[face], m); !r.EqualThreshold(Vec3{0, 1, 0}, 1e-6) {
+			t.Errorf("CubeMapView(%v) up %v maps to %v, not +Y", face, ups[face], r)
+		}
+		if r := TransformCoordinate(pos, m); !r.EqualThreshold(Vec3{}, 1e-5) {
+			t.Errorf("CubeMapView(%v) position maps to %v, not the origin", face, r)
+		}
+	}
+
+	for _, face := range []int{-1, 6} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("CubeMapView(%v) did not panic", face)
+				}
+			}()
+			CubeMapView(face, pos)
+		}()
+	}
+}
+
+func TestSnapToTexelGrid(t *testing.T) {
+	t.Parallel()
+
+	const resolution = 512
+	min, max := Vec3{-10.3, 4.1, -1}, Vec3{21.7, 36.1, 50}
+	texel := (max[0] - min[0]) / resolution
+
+	smin, smax := SnapToTexelGrid(min, max, resolution)
+	for i := 0; i < 2; i++ {
+		if n := smin[i] / texel; Abs(n-float64(math.Floor(float64(n)+0.5))) > 1e-3 {
+			t.Errorf("SnapToTexelGrid min[%d] = %v is not a whole number of texels (%v)", i, smin[i], n)
+		}
+		if !FloatEqualThreshold(smax[i]-smin[i], max[i]-min[i], 1e-5) {
+			t.Errorf("SnapToTexelGrid changed the size along axis %d from %v to %v", i, max[i]-min[i], smax[i]-smin[i])
+		}
+		if smin[i] > min[i] || min[i]-smin[i] >= texel {
+			t.Errorf("SnapToTexelGrid moved min[%d] from %v to %v, more than a texel or the wrong way", i, min[i], smin[i])
+		}
+	}
+	if smin[2] != min[2] || smax[2] != max[2] {
+		t.Errorf("SnapToTexelGrid altered Z bounds (got %v, %v)", smin[2], smax[2])
+	}
+
+	// Moving the volume by less than a texel should not move the snapped volume at all,
+	// and moving it by whole texels should move the snapped volume by the same amount.
+	nudge := Vec3{texel / 4, texel / 4, 0}
+	if nmin, _ := SnapToTexelGrid(smin.Add(nudge), smax.Add(nudge), resolution); !nmin.EqualThreshold(smin, 1e-4) {
+		t.Errorf("SnapToTexelGrid moved by a sub-texel nudge: %v -> %v", smin, nmin)
+	}
+
+	step := Vec3{3 * texel, -2 * texel, 0}
+	if nmin, _ := SnapToTexelGrid(min.Add(step), max.Add(step), resolution); !nmin.Sub(smin).EqualThreshold(step, 1e-4) {
+		t.Errorf("SnapToTexelGrid moved by %v, expected %v", nmin.Sub(smin), step)
+	}
+
+	for _, res := range []int{0, -512} {
+		if rmin, rmax := SnapToTexelGrid(min, max, res); rmin != min || rmax != max {
+			t.Errorf("SnapToTexelGrid(%v, %v, %v) != %v, %v (got %v, %v)", min, max, res, min, max, rmin, rmax)
+		}
+	}
+}
+
+func TestLeftHanded(t *testing.T) {
+	t.Parallel()
+
+	eye, center, up := Vec3{1, 2, 3}, Vec3{1, 2, -7}, Vec3{0, 1, 0}
+
+	// The point being looked at is in front of the camera: -Z for right-handed, +Z for left-handed
+	if r := TransformCoordinate(center, LookAtV(eye, center, up)); !r.EqualThreshold(Vec3{0, 0, -10}, 1e-4) {
+		t.Errorf("LookAtV maps center to %v, expected %v", r, Vec3{0, 0, -10})
+	}
+	if r := TransformCoordinate(center, LookAtLH(eye, center, up)); !r.EqualThreshold(Vec3{0, 0, 10}, 1e-4) {
+		t.Errorf("LookAtLH maps center to %v, expected %v", r, Vec3{0, 0, 10})
+	}
+	if r := TransformCoordinate(eye.Add(up), LookAtLH(eye, center, up)); !r.EqualThreshold(Vec3{0, 1, 0}, 1e-4) {
+		t.Errorf("LookAtLH maps up to %v, expected %v", r, Vec3{0, 1, 0})
+	}
+
+	// Near and far planes map to -1 and 1 in NDC, on opposite sides of the eye
+	const near, far = 0.5, 50
+	projections := []struct {
+		Name      string
+		M         Mat4
+		Direction float64
+	}{
+		{"Perspective", Perspective(DegToRad(60), 1.5, near, far), -1},
+		{"PerspectiveLH", PerspectiveLH(DegToRad(60), 1.5, near, far), 1},
+		{"Ortho", Ortho(-2, 2, -1, 1, near, far), -1},
+		{"OrthoLH", OrthoLH(-2, 2, -1, 1, near, far), 1},
+	}
+
+	for _, c := range projections {
+		if r := TransformCoordinate(Vec3{0, 0, c.Direction * near}, c.M); !FloatEqualThreshold(r[2], -1, 1e-4) {
+			t.Errorf("%v maps the near plane to depth %v, expected -1", c.Name, r[2])
+		}
+		if r := TransformCoordinate(Vec3{0, 0, c.Direction * far}, c.M); !FloatEqualThreshold(r[2], 1, 1e-4) {
+			t.Errorf("%v maps the far plane to depth %v, expected 1", c.Name, r[2])
+		}
+	}
+}
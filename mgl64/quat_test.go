@@ -115,6 +115,43 @@ func TestAnglesToQuatZYX(t *testing.T) {
 	}
 }
 
+func TestQuatFromEuler(t *testing.T) {
+	t.Parallel()
+
+	angles := Vec3{0.3, -1.2, 2.5}
+	x := QuatRotate(angles[0], Vec3{1, 0, 0})
+	y := QuatRotate(angles[1], Vec3{0, 1, 0})
+	z := QuatRotate(angles[2], Vec3{0, 0, 1})
+
+	tests := []struct {
+		Order    RotationOrder
+		Name     string
+		Expected Quat
+	}{
+		{XYZ, "XYZ", x.Mul(y).Mul(z)},
+		{XZY, "XZY", x.Mul(z).Mul(y)},
+		{YXZ, "YXZ", y.Mul(x).Mul(z)},
+		{YZX, "YZX", y.Mul(z).Mul(x)},
+		{ZXY, "ZXY", z.Mul(x).Mul(y)},
+		{ZYX, "ZYX", z.Mul(y).Mul(x)},
+	}
+
+	for _, c := range tests {
+		if r := QuatFromEuler(angles, c.Order); !r.OrientationEqualThreshold(c.Expected, 1e-5) {
+			t.Errorf("QuatFromEuler(%v, %v) != %v (got %v)", angles, c.Name, c.Expected, r)
+		}
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("QuatFromEuler with a proper Euler order did not panic")
+			}
+		}()
+		QuatFromEuler(angles, XYX)
+	}()
+}
+
 func TestQuatMatRotateY(t *testing.T) {
 	t.Parallel()
 
@@ -172,6 +209,21 @@ func BenchmarkQuatRotateConjugate(b *testing.B) {
 	}
 }
 
+func BenchmarkQuatRotateVec3(b *testing.B) {
+	b.StopTimer()
+	rand := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		q := QuatRotate(rand.Float64(), Vec3{rand.Float64(), rand.Float64(), rand.Float64()})
+		v := Vec3{rand.Float64(), rand.Float64(), rand.Float64()}
+		q = q.Normalize()
+		b.StartTimer()
+
+		v = q.RotateVec3(v)
+	}
+}
+
 func BenchmarkQuatArrayAccess(b *testing.B) {
 	b.StopTimer()
 	rand := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
@@ -303,6 +355,21 @@ func TestQuatRotate(t *testing.T) {
 	}
 }
 
+func TestQuatRotateVec3(t *testing.T) {
+	rand := rand.New(rand.NewSource(int64(time.Now().Nanosecond())))
+
+	for i := 0; i < 100; i++ {
+		axis := Vec3{rand.Float64() - 0.5, rand.Float64() - 0.5, rand.Float64() - 0.5}.Normalize()
+		q := QuatRotate(rand.Float64()*2*math.Pi, axis)
+		v := Vec3{rand.Float64()*10 - 5, rand.Float64()*10 - 5, rand.Float64()*10 - 5}
+
+		// Components near zero are compared absolutely, since a relative test fails there
+		if r, e := q.RotateVec3(v), q.Rotate(v); !r.ApproxFuncEqual(e, absEqual(1e-4)) {
+			t.Errorf("Quat(%v).RotateVec3(%v) != %v (got %v)", q, v, e, r)
+		}
+	}
+}
+
 func TestQuatLookAtV(t *testing.T) {
 	// http://www.euclideanspace.com/maths/algebra/realNormedAlgebra/quaternions/transforms/examples/index.htm
 
@@ -356,6 +423,105 @@ func TestQuatLookAtV(t *testing.T) {
 	}
 }
 
+func TestQuatFromDirection(t *testing.T) {
+	t.Parallel()
+
+	forward, yAxis := Vec3{0, 0, -1}, Vec3{0, 1, 0}
+
+	tests := []struct {
+		Description   string
+		Direction, Up Vec3
+	}{
+		{"identity", Vec3{0, 0, -1}, Vec3{0, 1, 0}},
+		{"turn around", Vec3{0, 0, 1}, Vec3{0, 1, 0}},
+		{"right", Vec3{1, 0, 0}, Vec3{0, 1, 0}},
+		{"unnormalized diagonal", Vec3{3, 2, -1}, Vec3{0, 1, 0}},
+		{"tilted up vector", Vec3{1, 0, 1}, Vec3{0.2, 1, 0}},
+		{"straight up", Vec3{0, 1, 0}, Vec3{0, 1, 0}},
+		{"straight down", Vec3{0, -5, 0}, Vec3{0, 1, 0}},
+	}
+
+	for _, c := range tests {
+		q := QuatFromDirection(c.Direction, c.Up)
+		if !FloatEqualThreshold(q.Len(), 1, 1e-5) {
+			t.Errorf("%v failed: QuatFromDirection(%v, %v) is not a unit quaternion (got %v)", c.Description, c.Direction, c.Up, q)
+		}
+		if r := q.Rotate(forward); !r.EqualThreshold(c.Direction.Normalize(), 1e-5) {
+			t.Errorf("%v failed: QuatFromDirection(%v, %v) rotates forward to %v", c.Description, c.Direction, c.Up, r)
+		}
+
+		// The rotated up should be perpendicular to the direction, and on the
+		// same side as the requested up unless they're parallel
+		u := q.Rotate(yAxis)
+		if Abs(u.Dot(c.Direction.Normalize())) > 1e-5 {
+			t.Errorf("%v failed: QuatFromDirection(%v, %v) up %v is not perpendicular to direction", c.Description, c.Direction, c.Up, u)
+		}
+		if !c.Direction.IsParallel(c.Up, 1e-4) && u.Dot(c.Up) <= 0 {
+			t.Errorf("%v failed: QuatFromDirection(%v, %v) up %v faces away from %v", c.Description, c.Direction, c.Up, u, c.Up)
+		}
+	}
+}
+
+func TestYawOnlyLookAt(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Description       string
+		From, To, WorldUp Vec3
+	}{
+		{"ahead", Vec3{0, 0, 0}, Vec3{0, 0, -5}, Vec3{0, 1, 0}},
+		{"right and above", Vec3{1, 0, 1}, Vec3{4, 3, 1}, Vec3{0, 1, 0}},
+		{"behind and below", Vec3{0, 10, 0}, Vec3{-1, 0, 3}, Vec3{0, 1, 0}},
+		{"tilted up", Vec3{0, 0, 0}, Vec3{2, 1, -1}, Vec3{0.2, 1, 0.1}},
+	}
+
+	for _, c := range tests {
+		q := YawOnlyLookAt(c.From, c.To, c.WorldUp)
+		up := c.WorldUp.Normalize()
+
+		// No pitch or roll: the world up axis is left alone
+		if r := q.Rotate(up); !r.EqualThreshold(up, 1e-5) {
+			t.Errorf("%v failed: YawOnlyLookAt(%v, %v, %v) tilts up to %v", c.Description, c.From, c.To, c.WorldUp, r)
+		}
+
+		// The forward axis faces the target when seen from above
+		dir := c.To.Sub(c.From)
+		dir = dir.Sub(up.Mul(dir.Dot(up))).Normalize()
+		fwd := q.Rotate(Vec3{0, 0, -1})
+		fwd = fwd.Sub(up.Mul(fwd.Dot(up))).Normalize()
+		if !fwd.EqualThreshold(dir, 1e-5) {
+			t.Errorf("%v failed: YawOnlyLookAt(%v, %v, %v) faces %v, expected %v", c.Description, c.From, c.To, c.WorldUp, fwd, dir)
+		}
+	}
+
+	if q := YawOnlyLookAt(Vec3{1, 0, 1}, Vec3{1, 5, 1}, Vec3{0, 1, 0}); !q.OrientationEqualThreshold(QuatIdent(), 1e-6) {
+		t.Errorf("YawOnlyLookAt with vertically aligned points != identity (got %v)", q)
+	}
+}
+
+func TestAlignFrames(t *testing.T) {
+	t.Parallel()
+
+	from := HomogRotate3D(0.4, Vec3{1, 0, 1}.Normalize()).Mat3()
+	rot := QuatRotate(2.2, Vec3{-1, 3, 2}.Normalize())
+	to := rot.Mat4().Mat3().Mul3(from)
+
+	q := AlignFrames(from, to)
+	if !q.OrientationEqualThreshold(rot, 1e-5) {
+		t.Errorf("AlignFrames(%v, %v) != %v (got %v)", from, to, rot, q)
+	}
+
+	for i := 0; i < 3; i++ {
+		if r := q.Rotate(from.Col(i)); !r.EqualThreshold(to.Col(i), 1e-5) {
+			t.Errorf("AlignFrames does not carry basis vector %v onto %v (got %v)", from.Col(i), to.Col(i), r)
+		}
+	}
+
+	if q := AlignFrames(from, from); !q.OrientationEqualThreshold(QuatIdent(), 1e-5) {
+		t.Errorf("AlignFrames of a frame onto itself != identity (got %v)", q)
+	}
+}
+
 func TestCompareLookAt(t *testing.T) {
 	type OrigExp [2]Vec3
 
@@ -690,6 +856,38 @@ func TestQuatInverse(t *testing.T) {
 	}
 }
 
+func TestQuatInverseConjugate(t *testing.T) {
+	tests := []Quat{
+		{3, Vec3{-1, 4, 3}},
+		{0.5, Vec3{0.1, 0.2, 0.3}},
+		QuatRotate(1, Vec3{0, 1, 0}).Scale(2.5),
+	}
+
+	for _, q := range tests {
+		if r := q.Mul(q.Inverse()); !r.ApproxEqualFunc(QuatIdent(), absEqual(1e-6)) {
+			t.Errorf("Quat(%v).Mul(Inverse()) != identity (got %v)", q, r)
+		}
+
+		if r := q.Inverse().Mul(q); !r.ApproxEqualFunc(QuatIdent(), absEqual(1e-6)) {
+			t.Errorf("Quat(%v).Inverse().Mul(q) != identity (got %v)", q, r)
+		}
+
+		// For non-unit quaternions the conjugate is not the inverse
+		if r := q.Mul(q.Conjugate()); r.ApproxEqualFunc(QuatIdent(), absEqual(1e-4)) {
+			t.Errorf("Quat(%v).Mul(Conjugate()) is unexpectedly the identity", q)
+		}
+
+		if r, e := q.Conjugate(), (Quat{q.W, q.V.Mul(-1)}); r != e {
+			t.Errorf("Quat(%v).Conjugate() != %v (got %v)", q, e, r)
+		}
+	}
+
+	unit := QuatRotate(1, Vec3{1, 2, 3}.Normalize())
+	if !unit.Conjugate().ApproxEqualThreshold(unit.Inverse(), 1e-4) {
+		t.Errorf("Conjugate and Inverse of unit quaternion %v differ: %v, %v", unit, unit.Conjugate(), unit.Inverse())
+	}
+}
+
 func TestQuatSlerp(t *testing.T) {
 	tests := []struct {
 		A, B     Quat
@@ -713,6 +911,65 @@ func TestQuatSlerp(t *testing.T) {
 	}
 }
 
+func TestQuatSlerpN(t *testing.T) {
+	q1 := QuatRotate(0.3, Vec3{0, 1, 0})
+	q2 := QuatRotate(2, Vec3{1, 1, 0}.Normalize())
+
+	for _, n := range []int{2, 3, 10} {
+		quats := QuatSlerpN(q1, q2, n)
+		if len(quats) != n {
+			t.Fatalf("QuatSlerpN(%v, %v, %d) returned %d rotations", q1, q2, n, len(quats))
+		}
+
+		if !quats[0].ApproxEqualThreshold(q1, 1e-6) || !quats[n-1].ApproxEqualThreshold(q2, 1e-6) {
+			t.Errorf("QuatSlerpN(%v, %v, %d) does not start and end at the inputs (got %v, %v)", q1, q2, n, quats[0], quats[n-1])
+		}
+
+		step := QuatAngleBetween(q1, q2) / float64(n-1)
+		for i := 1; i < n; i++ {
+			if a := QuatAngleBetween(quats[i-1], quats[i]); !FloatEqualThreshold(a, step, 1e-4) {
+				t.Errorf("QuatSlerpN(%v, %v, %d) step %d turns by %v, expected %v", q1, q2, n, i, a, step)
+			}
+
+			e := QuatSlerp(q1, q2, float64(i)/float64(n-1))
+			if !quats[i].ApproxEqualThreshold(e, 1e-5) {
+				t.Errorf("QuatSlerpN(%v, %v, %d)[%d] != %v (got %v)", q1, q2, n, i, e, quats[i])
+			}
+		}
+	}
+
+	if r := QuatSlerpN(q1, q2, 1); len(r) != 1 || !r[0].ApproxEqualThreshold(q1, 1e-6) {
+		t.Errorf("QuatSlerpN(%v, %v, 1) != [%v] (got %v)", q1, q2, q1, r)
+	}
+
+	if r := QuatSlerpN(q1, q2, 0); r != nil {
+		t.Errorf("QuatSlerpN(%v, %v, 0) != nil (got %v)", q1, q2, r)
+	}
+
+	// Antipodal and nearly antipodal inputs are (almost) the same orientation, so
+	// every step is a unit quaternion turning by a tiny, even amount
+	for _, angle := range []float64{0, 0.01} {
+		a := q2.Scale(-1)
+		if angle != 0 {
+			a = QuatRotate(angle, Vec3{0, 0, 1}).Mul(a)
+		}
+
+		const n = 5
+		quats := QuatSlerpN(q2, a, n)
+		if !quats[n-1].ApproxEqualThreshold(a, 1e-6) {
+			t.Errorf("QuatSlerpN(%v, %v, %d) does not end at the input (got %v)", q2, a, n, quats[n-1])
+		}
+		for i, q := range quats {
+			if l := q.Len(); !FloatEqualThreshold(l, 1, 1e-5) {
+				t.Errorf("QuatSlerpN(%v, %v, %d)[%d] is not unit length (got %v)", q2, a, n, i, q)
+			}
+			if r, e := QuatAngleBetween(q2, q), angle*float64(i)/(n-1); Abs(r-e) > 1e-3 {
+				t.Errorf("QuatSlerpN(%v, %v, %d)[%d] is %v from the start, expected %v", q2, a, n, i, r, e)
+			}
+		}
+	}
+}
+
 func TestQuatDot(t *testing.T) {
 	tests := []struct {
 		A, B     Quat
@@ -729,3 +986,126 @@ func TestQuatDot(t *testing.T) {
 		}
 	}
 }
+
+func TestQuatAngleBetween(t *testing.T) {
+	axis := Vec3{1, 2, 3}.Normalize()
+	q := QuatRotate(0.7, axis)
+
+	tests := []struct {
+		A, B     Quat
+		Expected float64
+	}{
+		{QuatIdent(), QuatIdent(), 0},
+		{q, q, 0},
+		{q, q.Scale(-1), 0},
+		{QuatIdent(), QuatRotate(math.Pi/2, Vec3{0, 1, 0}), math.Pi / 2},
+		{QuatIdent(), QuatRotate(math.Pi, Vec3{1, 0, 0}), math.Pi},
+		{q, QuatRotate(math.Pi, Vec3{0, 0, 1}).Mul(q), math.Pi},
+		{q, QuatRotate(0.2, axis), 0.5},
+		{QuatRotate(0.2, axis), q.Scale(3), 0.5},
+	}
+
+	for _, c := range tests {
+		if r := QuatAngleBetween(c.A, c.B); Abs(r-c.Expected) > 1e-4 {
+			t.Errorf("QuatAngleBetween(%v, %v) != %v (got %v)", c.A, c.B, c.Expected, r)
+		}
+	}
+}
+
+func TestQuatAngularErrorDeg(t *testing.T) {
+	a := QuatRotate(1.2, Vec3{-1, 2, 0.5}.Normalize())
+	tests := []struct {
+		Offset   Quat
+		Expected float64
+	}{
+		{QuatIdent(), 0},
+		{QuatRotate(DegToRad(0.5), Vec3{0, 0, 1}), 0.5},
+		{QuatRotate(DegToRad(-2), Vec3{1, 1, 1}.Normalize()), 2},
+		{QuatRotate(DegToRad(90), Vec3{0, 1, 0}), 90},
+		{QuatRotate(DegToRad(180), Vec3{1, 0, 0}), 180},
+		{QuatRotate(DegToRad(10), Vec3{1, 0, 0}).Scale(-1), 10},
+	}
+
+	for _, c := range tests {
+		b := c.Offset.Mul(a)
+		if r := QuatAngularErrorDeg(a, b); Abs(r-c.Expected) > 1e-2 {
+			t.Errorf("QuatAngularErrorDeg(%v, %v) != %v (got %v)", a, b, c.Expected, r)
+		}
+	}
+}
+
+func TestQuatWeightedBlend(t *testing.T) {
+	q1 := QuatRotate(0.3, Vec3{0, 1, 0})
+	q2 := QuatRotate(1.1, Vec3{1, 0, 1}.Normalize())
+	q3 := QuatRotate(-0.4, Vec3{0, 0, 1})
+
+	// Equal weights reduce to the normalized simple average.
+	if r, err := QuatWeightedBlend([]Quat{q1, q2, q3}, []float64{2, 2, 2}); err != nil {
+		t.Errorf("QuatWeightedBlend returned error %v", err)
+	} else if e := q1.Add(q2).Add(q3).Normalize(); !r.ApproxEqualThreshold(e, 1e-4) {
+		t.Errorf("QuatWeightedBlend with equal weights != %v (got %v)", e, r)
+	}
+
+	// Two rotations match QuatNlerp, regardless of the sign of either input.
+	e := QuatNlerp(q1, q2, 0.25)
+	for _, in := range [][]Quat{{q1, q2}, {q1, q2.Scale(-1)}, {q1.Scale(-1), q2}} {
+		if r, err := QuatWeightedBlend(in, []float64{0.75, 0.25}); err != nil {
+			t.Errorf("QuatWeightedBlend returned error %v", err)
+		} else if !r.OrientationEqualThreshold(e, 1e-4) {
+			t.Errorf("QuatWeightedBlend(%v, [0.75 0.25]) != %v (got %v)", in, e, r)
+		}
+	}
+
+	if r, err := QuatWeightedBlend([]Quat{q2}, []float64{0.5}); err != nil || !r.ApproxEqualThreshold(q2, 1e-4) {
+		t.Errorf("QuatWeightedBlend of a single rotation != %v (got %v, %v)", q2, r, err)
+	}
+
+	for _, c := range []struct {
+		quats   []Quat
+		weights []float64
+	}{
+		{nil, nil},
+		{[]Quat{q1, q2}, []float64{1}},
+		{[]Quat{q1, q2}, []float64{0, 0}},
+	} {
+		if _, err := QuatWeightedBlend(c.quats, c.weights); err == nil {
+			t.Errorf("QuatWeightedBlend(%v, %v) did not return an error", c.quats, c.weights)
+		}
+	}
+}
+
+func TestQuatIntegrate(t *testing.T) {
+	start := QuatRotate(0.7, Vec3{1, 2, 0}.Normalize())
+	omega := Vec3{0.5, -1, 2}
+
+	const steps = 1000
+	const duration = 2
+	q := start
+	for i := 0; i < steps; i++ {
+		q = QuatIntegrate(q, omega, duration/float64(steps))
+	}
+
+	// A constant angular velocity is a rotation about its axis by |omega|*t. The
+	// error of each step is third order in its angle, so what remains is mostly
+	// rounding accumulated over the steps.
+	e := QuatRotate(omega.Len()*duration, omega.Normalize()).Mul(start)
+	if a := QuatAngleBetween(q, e); a > 1e-4 {
+		t.Errorf("QuatIntegrate over %v steps != %v (got %v, %v radians off)", steps, e, q, a)
+	}
+
+	// A single small step matches the closed form closely, which a half-angle or
+	// wrong-frame (body instead of world) update would not
+	const dt = 1e-3
+	r := QuatIntegrate(start, omega, dt)
+	e = QuatRotate(omega.Len()*dt, omega.Normalize()).Mul(start)
+	if a := QuatAngleBetween(r, e); a > 1e-5 {
+		t.Errorf("QuatIntegrate(%v, %v, %v) != %v (got %v, %v radians off)", start, omega, dt, e, r, a)
+	}
+	if l := q.Len(); !FloatEqualThreshold(l, 1, 1e-5) {
+		t.Errorf("QuatIntegrate result has length %v, expected 1", l)
+	}
+
+	if r := QuatIntegrate(start, Vec3{}, 0.1); !r.ApproxEqualThreshold(start, 1e-6) {
+		t.Errorf("QuatIntegrate with zero angular velocity != %v (got %v)", start, r)
+	}
+}
@@ -96,6 +96,136 @@ func CubicBezierCurve3D(t float64, cPoint1, cPoint2, cPoint3, cPoint4 Vec3) Vec3
 	return cPoint1.Mul((1 - t) * (1 - t) * (1 - t)).Add(cPoint2.Mul(3 * (1 - t) * (1 - t) * t)).Add(cPoint3.Mul(3 * (1 - t) * t * t)).Add(cPoint4.Mul(t * t * t))
 }
 
+// CatmullRomCurve3D evaluates a single Catmull-Rom spline segment at t. The curve passes
+// through cPoint2 at t=0 and cPoint3 at t=1, while cPoint1 and cPoint4 only shape the
+// tangents at either end.
+//
+// Like the bezier functions, t must be in the range [0.0,1.0] or this function will panic.
+func CatmullRomCurve3D(t float64, cPoint1, cPoint2, cPoint3, cPoint4 Vec3) Vec3 {
+	if t < 0.0 || t > 1.0 {
+		panic("Can't interpolate on catmull-rom curve with t out of range [0.0,1.0]")
+	}
+
+	t2, t3 := t*t, t*t*t
+
+	return cPoint2.Mul(2).
+		Add(cPoint3.Sub(cPoint1).Mul(t)).
+		Add(cPoint1.Mul(2).Sub(cPoint2.Mul(5)).Add(cPoint3.Mul(4)).Sub(cPoint4).Mul(t2)).
+		Add(cPoint2.Mul(3).Sub(cPoint1).Sub(cPoint3.Mul(3)).Add(cPoint4).Mul(t3)).
+		Mul(0.5)
+}
+
+// CardinalSpline evaluates a single cardinal spline segment at t. Like a Catmull-Rom
+// segment, the curve passes through p1 at t=0 and p2 at t=1, with the tangents at
+// those points being tension*(p2-p0) and tension*(p3-p1) respectively.
+//
+// A tension of 0.5 gives the standard Catmull-Rom curve (see CatmullRomCurve3D),
+// smaller values make the curve tighter around the control points, with 0 giving
+// straight lines, and larger values make it looser.
+//
+// Like the bezier functions, t must be in the range [0.0,1.0] or this function will panic.
+func CardinalSpline(p0, p1, p2, p3 Vec3, tension, t float64) Vec3 {
+	if t < 0.0 || t > 1.0 {
+		panic("Can't interpolate on cardinal spline with t out of range [0.0,1.0]")
+	}
+
+	t2, t3 := t*t, t*t*t
+
+	// Hermite basis functions
+	h00 := 2*t3 - 3*t2 + 1
+	h10 := t3 - 2*t2 + t
+	h01 := -2*t3 + 3*t2
+	h11 := t3 - t2
+
+	m1 := p2.Sub(p0).Mul(tension)
+	m2 := p3.Sub(p1).Mul(tension)
+
+	return p1.Mul(h00).Add(m1.Mul(h10)).Add(p2.Mul(h01)).Add(m2.Mul(h11))
+}
+
+// Catenary returns the point at t along a cable of uniform weight hanging between a
+// and b, such as a rope, chain, or power line. Gravity pulls along -Y, so the cable
+// hangs in the vertical plane containing a and b and droops downward.
+//
+// The slack is the cable's extra length relative to the straight-line distance
+// between the end points: a slack of 0 gives a taut straight line, 0.1 a cable 10%
+// longer than the distance between a and b, and so on. Negative slack is treated
+// as 0.
+//
+// t moves uniformly along the horizontal span, with t=0 giving a and t=1 giving b.
+// If a and b are vertically aligned there is no span to hang across, and the result
+// is simply the straight line between them.
+//
+// Like the bezier functions, t must be in the range [0.0,1.0] or this function will panic.
+func Catenary(a, b Vec3, slack, t float64) Vec3 {
+	if t < 0.0 || t > 1.0 {
+		panic("Can't interpolate on catenary with t out of range [0.0,1.0]")
+	}
+
+	line := b.Sub(a)
+	horiz := Vec3{line[0], 0, line[2]}
+	h, v := float64(horiz.Len()), float64(line[1])
+	length := float64(line.Len()) * (1 + math.Max(float64(slack), 0))
+
+	if slack <= 0 || h <= 1e-6*length {
+		return a.Add(line.Mul(t))
+	}
+
+	// In the vertical plane, with a at the origin and b at (h, v), the cable is
+	// y = c*cosh((x-x1)/c) + y0. The scale c is the solution of
+	// sqrt(length^2 - v^2) = 2c*sinh(h/(2c)), found by Newton's method on
+	// z = h/(2c), that is, the root of sinh(z) = r*z.
+	//
+	// For small r, sinh(z)/z >= 1 + z^2/6 means sqrt(6(r-1)) lies above the root,
+	// from where the iteration converges monotonically. For large r that estimate is
+	// far too high (sinh may even overflow), so start from the asymptotic solution
+	// log(2r) + log(log(2r)) instead. It lies just below the root, and the first
+	// step lands above it, after which convergence is again monotonic.
+	r := math.Sqrt(length*length-v*v) / h
+	z := math.Sqrt(6 * (r - 1))
+	if r > 3 {
+		l := math.Log(2 * r)
+		z = l + math.Log(l)
+	}
+	for i := 0; i < 100; i++ {
+		step := (math.Sinh(z) - r*z) / (math.Cosh(z) - r)
+		z -= step
+		if math.Abs(step) < 1e-12*z {
+			break
+		}
+	}
+
+	c := h / (2 * z)
+	x1 := h/2 - c*math.Atanh(v/length)
+	x := float64(t) * h
+	y := c * (math.Cosh((x-x1)/c) - math.Cosh(x1/c))
+
+	return a.Add(horiz.Mul(t)).Add(Vec3{0, float64(y), 0})
+}
+
+// FibonacciSphere returns n points spread nearly uniformly over the unit sphere, for
+// instance to use as sample directions. The points lie on a spiral running from the
+// +Y pole to the -Y pole: they are evenly spaced in Y, which makes them evenly spaced
+// by area, and each is turned by the golden angle from the previous one around the
+// Y axis, which keeps any two from lining up. Returns nil if n is not positive.
+func FibonacciSphere(n int) []Vec3 {
+	if n <= 0 {
+		return nil
+	}
+
+	goldenAngle := math.Pi * (3 - math.Sqrt(5))
+	points := make([]Vec3, n)
+	for i := range points {
+		// Offsetting by half a step keeps the first and last points off the poles
+		y := 1 - (2*float64(i)+1)/float64(n)
+		r := math.Sqrt(1 - y*y)
+		sin, cos := math.Sincos(goldenAngle * float64(i))
+		points[i] = Vec3{float64(r * cos), float64(y), float64(r * sin)}
+	}
+
+	return points
+}
+
 // Returns the point at point t along an n-control point Bezier curve
 //
 // t must be in the range 0.0 and 1.0 or this function will panic. Consider [0.0,1.0] to be similar to a percentage,
@@ -7,6 +7,7 @@
 package mgl64
 
 import (
+	"math"
 	"testing"
 )
 
@@ -103,3 +104,116 @@ func Test_choose(t *testing.T) {
 		// })
 	}
 }
+
+func TestCatenary(t *testing.T) {
+	eq := absEqual(1e-4)
+
+	for _, ends := range [][2]Vec3{
+		{{0, 0, 0}, {10, 0, 0}},
+		{{-2, 5, 1}, {3, 1, -4}},
+		{{0, 0, 0}, {1, 8, 0}},
+	} {
+		a, b := ends[0], ends[1]
+		for _, slack := range []float64{0.01, 0.2, 2} {
+			if r := Catenary(a, b, slack, 0); !r.ApproxFuncEqual(a, eq) {
+				t.Errorf("Catenary(%v, %v, %v, 0) != %v (got %v)", a, b, slack, a, r)
+			}
+
+			if r := Catenary(a, b, slack, 1); !r.ApproxFuncEqual(b, eq) {
+				t.Errorf("Catenary(%v, %v, %v, 1) != %v (got %v)", a, b, slack, b, r)
+			}
+
+			// The midpoint sags below the straight line, without moving sideways
+			mid, straight := Catenary(a, b, slack, 0.5), a.Add(b).Mul(0.5)
+			if mid[1] >= straight[1] || !FloatEqualThreshold(mid[0], straight[0], 1e-4) || !FloatEqualThreshold(mid[2], straight[2], 1e-4) {
+				t.Errorf("Catenary(%v, %v, %v, 0.5) does not droop below %v (got %v)", a, b, slack, straight, mid)
+			}
+
+			// The cable has the requested length
+			var length float64
+			prev := a
+			for i := 1; i <= 1000; i++ {
+				p := Catenary(a, b, slack, float64(i)/1000)
+				length += p.Sub(prev).Len()
+				prev = p
+			}
+			if e := b.Sub(a).Len() * (1 + slack); !FloatEqualThreshold(length, e, 1e-3) {
+				t.Errorf("Catenary(%v, %v, %v) has length %v (expected %v)", a, b, slack, length, e)
+			}
+		}
+
+		if r, e := Catenary(a, b, 0, 0.25), a.Add(b.Sub(a).Mul(0.25)); !r.ApproxFuncEqual(e, eq) {
+			t.Errorf("Catenary(%v, %v, 0, 0.25) != %v (got %v)", a, b, e, r)
+		}
+	}
+
+	// Near-vertical spans and large slack make for extremely tight curves. The cable
+	// then runs almost straight down from a and back up to b, so its lowest point is
+	// about (length - |v|)/2 below the lower end.
+	for _, c := range []struct {
+		A, B  Vec3
+		Slack float64
+	}{
+		{Vec3{0, 0, 0}, Vec3{0.001, 10, 0}, 0.1},
+		{Vec3{0, 0, 0}, Vec3{0.00002, 10, 0}, 0.1},
+		{Vec3{0, 0, 0}, Vec3{1, 0, 0}, 1e3},
+		{Vec3{0, 0, 0}, Vec3{1, 0, 0}, 1e5},
+	} {
+		if r := Catenary(c.A, c.B, c.Slack, 1); !r.ApproxFuncEqual(c.B, eq) {
+			t.Errorf("Catenary(%v, %v, %v, 1) != %v (got %v)", c.A, c.B, c.Slack, c.B, r)
+		}
+
+		line := c.B.Sub(c.A)
+		e := c.A[1] - (line.Len()*(1+c.Slack)-Abs(line[1]))/2
+		if r := Catenary(c.A, c.B, c.Slack, 0.5); !FloatEqualThreshold(r[1], e, 1e-3) {
+			t.Errorf("Catenary(%v, %v, %v, 0.5) has its midpoint at %v, expected a height of about %v", c.A, c.B, c.Slack, r, e)
+		}
+	}
+}
+
+func TestFibonacciSphere(t *testing.T) {
+	if r := FibonacciSphere(0); r != nil {
+		t.Errorf("FibonacciSphere(0) != nil (got %v)", r)
+	}
+
+	for _, n := range []int{1, 2, 50, 500} {
+		points := FibonacciSphere(n)
+		if len(points) != n {
+			t.Fatalf("FibonacciSphere(%d) returned %d points", n, len(points))
+		}
+
+		var centroid Vec3
+		for _, p := range points {
+			if l := p.Len(); !FloatEqualThreshold(l, 1, 1e-5) {
+				t.Errorf("FibonacciSphere(%d) point %v is not unit length (got %v)", n, p, l)
+			}
+			centroid = centroid.Add(p)
+		}
+
+		if n < 50 {
+			continue
+		}
+
+		// Evenly spread points balance out
+		if c := centroid.Mul(1 / float64(n)); c.Len() > 0.02 {
+			t.Errorf("FibonacciSphere(%d) is lopsided, centroid %v", n, c)
+		}
+
+		// Every point's nearest neighbor is at about the same distance
+		var sum, sumSq float64
+		for i, p := range points {
+			nearest := float64(math.MaxFloat64)
+			for j, q := range points {
+				if d := p.Sub(q).Len(); i != j && d < nearest {
+					nearest = d
+				}
+			}
+			sum += float64(nearest)
+			sumSq += float64(nearest * nearest)
+		}
+		mean := sum / float64(n)
+		if cv := math.Sqrt(sumSq/float64(n)-mean*mean) / mean; cv > 0.1 {
+			t.Errorf("FibonacciSphere(%d) nearest neighbor distances vary too much (coefficient of variation %v)", n, cv)
+		}
+	}
+}
@@ -143,6 +143,88 @@ func TestExtractMaxScale(t *testing.T) {
 	}
 }
 
+func TestMat4Translation(t *testing.T) {
+	tests := []struct {
+		M Mat4
+		V Vec3
+	}{
+		{Ident4(), Vec3{0, 0, 0}},
+		{Translate3D(1, 2, 3), Vec3{1, 2, 3}},
+		{Translate3D(10, 12, -5).Mul4(HomogRotate3D(math.Pi/2, Vec3{1, 0, 0})).Mul4(Scale3D(2, 3, 4)), Vec3{10, 12, -5}},
+	}
+
+	for _, c := range tests {
+		if r := c.M.Translation(); !r.ApproxEqualThreshold(c.V, 1e-6) {
+			t.Errorf("Mat4(%v).Translation() != %v (got %v)", c.M, c.V, r)
+		}
+	}
+}
+
+func TestMat4SetTranslation(t *testing.T) {
+	m := HomogRotate3D(math.Pi/3, Vec3{0, 1, 0}).Mul4(Scale3D(2, 3, 4))
+	orig := m
+
+	m.SetTranslation(Vec3{4, 5, 6})
+
+	if r := m.Translation(); !r.ApproxEqual(Vec3{4, 5, 6}) {
+		t.Errorf("SetTranslation did not set the translation, expected %v (got %v)", Vec3{4, 5, 6}, r)
+	}
+
+	if !m.Mat3().ApproxEqual(orig.Mat3()) {
+		t.Errorf("SetTranslation altered the upper-left 3x3, expected %v (got %v)", orig.Mat3(), m.Mat3())
+	}
+
+	if m.Row(3) != orig.Row(3) {
+		t.Errorf("SetTranslation altered the bottom row, expected %v (got %v)", orig.Row(3), m.Row(3))
+	}
+}
+
+func TestMat4Round(t *testing.T) {
+	m := Mat4{
+		0.9999998, 0.7, -0.0000001, 0,
+		1e-7, -1.0000002, 0.5, 0,
+		2.0000001, 3.3, 0.9999, 0,
+		10.0000005, -4.9999995, 0.3, 1,
+	}
+	expected := Mat4{
+		1, 0.7, 0, 0,
+		0, -1, 0.5, 0,
+		2, 3.3, 0.9999, 0,
+		10, -5, 0.3, 1,
+	}
+
+	if r := m.Round(1e-5); r != expected {
+		t.Errorf("Mat4(%v).Round(1e-5) != %v (got %v)", m, expected, r)
+	}
+
+	if r := Ident4().Round(1e-5); r != Ident4() {
+		t.Errorf("Ident4().Round(1e-5) != %v (got %v)", Ident4(), r)
+	}
+}
+
+func TestMulAll(t *testing.T) {
+	scale := Scale3D(2, 3, 4)
+	rot := HomogRotate3DY(DegToRad(90))
+	trans := Translate3D(4, 5, 6)
+
+	tests := []struct {
+		Mats     []Mat4
+		Expected Mat4
+	}{
+		{nil, Ident4()},
+		{[]Mat4{trans}, trans},
+		{[]Mat4{trans, rot}, trans.Mul4(rot)},
+		{[]Mat4{trans, rot, scale}, trans.Mul4(rot).Mul4(scale)},
+		{[]Mat4{scale, rot, trans}, scale.Mul4(rot).Mul4(trans)},
+	}
+
+	for _, c := range tests {
+		if r := MulAll(c.Mats...); !r.ApproxEqualThreshold(c.Expected, 1e-4) {
+			t.Errorf("MulAll(%v) != %v (got %v)", c.Mats, c.Expected, r)
+		}
+	}
+}
+
 func TestTransformCoordinate(t *testing.T) {
 	tests := [...]struct {
 		v Vec3
@@ -181,3 +263,194 @@ func TestTransformNormal(t *testing.T) {
 		}
 	}
 }
+
+func TestTangentSpaceMatrix(t *testing.T) {
+	tests := []struct {
+		Description     string
+		Normal, Tangent Vec3
+	}{
+		{"already orthonormal", Vec3{0, 0, 1}, Vec3{1, 0, 0}},
+		{"skewed tangent", Vec3{0, 1, 0}, Vec3{1, 1, 0}},
+		{"unnormalized", Vec3{1, 2, 3}, Vec3{-4, 0.5, 2}},
+		{"parallel tangent", Vec3{0, 0, 1}, Vec3{0, 0, 2}},
+		{"antiparallel tangent", Vec3{1, 1, 0}, Vec3{-1, -1, 0}},
+		{"zero tangent", Vec3{0.2, -1, 0.3}, Vec3{}},
+	}
+
+	for _, c := range tests {
+		m := TangentSpaceMatrix(c.Normal, c.Tangent)
+		if r := m.Transpose().Mul3(m); !r.ApproxFuncEqual(Ident3(), absEqual(1e-5)) {
+			t.Errorf("%v failed: TangentSpaceMatrix(%v, %v) = %v is not orthonormal", c.Description, c.Normal, c.Tangent, m)
+		}
+		if !FloatEqualThreshold(m.Det(), 1, 1e-5) {
+			t.Errorf("%v failed: TangentSpaceMatrix(%v, %v) is not right-handed (det %v)", c.Description, c.Normal, c.Tangent, m.Det())
+		}
+		if n := m.Col(2); !n.EqualThreshold(c.Normal.Normalize(), 1e-6) {
+			t.Errorf("%v failed: TangentSpaceMatrix(%v, %v) normal column != %v (got %v)", c.Description, c.Normal, c.Tangent, c.Normal.Normalize(), n)
+		}
+	}
+
+	// The tangent should only be straightened, not replaced
+	if tan := TangentSpaceMatrix(Vec3{0, 1, 0}, Vec3{1, 1, 0}).Col(0); !tan.EqualThreshold(Vec3{1, 0, 0}, 1e-6) {
+		t.Errorf("TangentSpaceMatrix tangent != %v (got %v)", Vec3{1, 0, 0}, tan)
+	}
+}
+
+func TestTangentBitangent(t *testing.T) {
+	// The lower right half of a quad spanning [0,2]x[0,2] in XY, mapped to [0,1]x[0,1]
+	p0, p1, p2 := Vec3{0, 0, 0}, Vec3{2, 0, 0}, Vec3{2, 2, 0}
+	tests := []struct {
+		Description        string
+		UV0, UV1, UV2      Vec2
+		Tangent, Bitangent Vec3
+	}{
+		{"aligned", Vec2{0, 0}, Vec2{1, 0}, Vec2{1, 1}, Vec3{1, 0, 0}, Vec3{0, 1, 0}},
+		{"flipped V", Vec2{0, 1}, Vec2{1, 1}, Vec2{1, 0}, Vec3{1, 0, 0}, Vec3{0, -1, 0}},
+		{"rotated", Vec2{0, 0}, Vec2{0, 1}, Vec2{-1, 1}, Vec3{0, -1, 0}, Vec3{1, 0, 0}},
+		{"scaled", Vec2{0.25, 0.5}, Vec2{0.75, 0.5}, Vec2{0.75, 3}, Vec3{1, 0, 0}, Vec3{0, 1, 0}},
+	}
+
+	for _, c := range tests {
+		tan, bitan := TangentBitangent(p0, p1, p2, c.UV0, c.UV1, c.UV2)
+		if !tan.EqualThreshold(c.Tangent, 1e-6) || !bitan.EqualThreshold(c.Bitangent, 1e-6) {
+			t.Errorf("%v failed: TangentBitangent(%v, %v, %v, %v, %v, %v) != %v, %v (got %v, %v)",
+				c.Description, p0, p1, p2, c.UV0, c.UV1, c.UV2, c.Tangent, c.Bitangent, tan, bitan)
+		}
+	}
+
+	// Degenerate UVs fall back to a frame in the plane of the triangle
+	tan, bitan := TangentBitangent(p0, p1, p2, Vec2{0, 0}, Vec2{1, 1}, Vec2{2, 2})
+	n := Vec3{0, 0, 1}
+	if !FloatEqualThreshold(tan.Len(), 1, 1e-6) || !FloatEqualThreshold(tan.Dot(n), 0, 1e-6) {
+		t.Errorf("TangentBitangent with degenerate UVs returned tangent %v, not a unit vector in the XY plane", tan)
+	}
+	if e := n.Cross(tan); !bitan.EqualThreshold(e, 1e-6) {
+		t.Errorf("TangentBitangent with degenerate UVs returned bitangent %v, expected %v", bitan, e)
+	}
+
+	if tan, bitan := TangentBitangent(p0, p0, p0, Vec2{0, 0}, Vec2{1, 1}, Vec2{2, 2}); tan != (Vec3{1, 0, 0}) || bitan != (Vec3{0, 1, 0}) {
+		t.Errorf("TangentBitangent of a degenerate triangle != %v, %v (got %v, %v)", Vec3{1, 0, 0}, Vec3{0, 1, 0}, tan, bitan)
+	}
+}
+
+func TestBillboardMatrix(t *testing.T) {
+	tests := []struct {
+		Description         string
+		Obj, Cam, Up        Vec3
+		ExpectedUpDirection Vec3
+	}{
+		{"in front", Vec3{0, 0, 0}, Vec3{0, 0, 5}, Vec3{0, 1, 0}, Vec3{0, 1, 0}},
+		{"to the side", Vec3{1, 2, 3}, Vec3{6, 2, 3}, Vec3{0, 1, 0}, Vec3{0, 1, 0}},
+		{"above at an angle", Vec3{0, 0, 0}, Vec3{3, 4, 0}, Vec3{0, 1, 0}, Vec3{-0.8, 0.6, 0}},
+		{"directly above", Vec3{0, 0, 0}, Vec3{0, 10, 0}, Vec3{0, 1, 0}, Vec3{}},
+	}
+
+	for _, c := range tests {
+		m := BillboardMatrix(c.Obj, c.Cam, c.Up)
+		toCam := c.Cam.Sub(c.Obj).Normalize()
+
+		if r := TransformNormal(Vec3{0, 0, 1}, m); !r.EqualThreshold(toCam, 1e-5) {
+			t.Errorf("%v failed: billboard forward %v does not point at the camera (%v)", c.Description, r, toCam)
+		}
+		if r := TransformCoordinate(Vec3{}, m); !r.EqualThreshold(c.Obj, 1e-5) {
+			t.Errorf("%v failed: billboard origin %v is not at the object (%v)", c.Description, r, c.Obj)
+		}
+		if rot := m.Mat3(); !rot.Transpose().Mul3(rot).ApproxFuncEqual(Ident3(), absEqual(1e-5)) {
+			t.Errorf("%v failed: billboard rotation %v is not orthonormal", c.Description, rot)
+		}
+		if c.ExpectedUpDirection != (Vec3{}) {
+			if r := TransformNormal(Vec3{0, 1, 0}, m); !r.EqualThreshold(c.ExpectedUpDirection, 1e-5) {
+				t.Errorf("%v failed: billboard up %v != %v", c.Description, r, c.ExpectedUpDirection)
+			}
+		}
+	}
+}
+
+func TestCylindricalBillboardMatrix(t *testing.T) {
+	axis := Vec3{0, 1, 0}
+
+	tests := []struct {
+		Description      string
+		Obj, Cam         Vec3
+		ExpectedToCamera Vec3
+	}{
+		{"level", Vec3{0, 0, 0}, Vec3{0, 0, 5}, Vec3{0, 0, 1}},
+		{"above", Vec3{1, 0, 1}, Vec3{4, 10, 5}, Vec3{0.6, 0, 0.8}},
+		{"on axis", Vec3{0, 0, 0}, Vec3{0, 10, 0}, Vec3{}},
+	}
+
+	for _, c := range tests {
+		m := CylindricalBillboardMatrix(c.Obj, c.Cam, axis)
+
+		if r := TransformNormal(Vec3{0, 1, 0}, m); !r.EqualThreshold(axis, 1e-5) {
+			t.Errorf("%v failed: cylindrical billboard up %v != axis %v", c.Description, r, axis)
+		}
+		if rot := m.Mat3(); !rot.Transpose().Mul3(rot).ApproxFuncEqual(Ident3(), absEqual(1e-5)) {
+			t.Errorf("%v failed: cylindrical billboard rotation %v is not orthonormal", c.Description, rot)
+		}
+		if c.ExpectedToCamera != (Vec3{}) {
+			if r := TransformNormal(Vec3{0, 0, 1}, m); !r.EqualThreshold(c.ExpectedToCamera, 1e-5) {
+				t.Errorf("%v failed: cylindrical billboard forward %v != %v", c.Description, r, c.ExpectedToCamera)
+			}
+		}
+	}
+}
+
+func TestReflectionMatrix(t *testing.T) {
+	tests := []struct {
+		Plane           Vec4
+		Point, Expected Vec3
+	}{
+		{Vec4{0, 0, 1, 0}, Vec3{1, 2, 3}, Vec3{1, 2, -3}},
+		{Vec4{0, 0, -4, 0}, Vec3{1, 2, 3}, Vec3{1, 2, -3}},
+		{Vec4{0, 1, 0, -2}, Vec3{5, 0, 1}, Vec3{5, 4, 1}},
+		{Vec4{1, 1, 0, 0}, Vec3{1, 0, 7}, Vec3{0, -1, 7}},
+	}
+
+	for _, c := range tests {
+		m := ReflectionMatrix(c.Plane)
+		if r := TransformCoordinate(c.Point, m); !r.EqualThreshold(c.Expected, 1e-5) {
+			t.Errorf("ReflectionMatrix(%v) maps %v to %v, expected %v", c.Plane, c.Point, r, c.Expected)
+		}
+
+		// Mirroring twice gives the original point back
+		if r := m.Mul4(m); !r.ApproxFuncEqual(Ident4(), absEqual(1e-5)) {
+			t.Errorf("ReflectionMatrix(%v) squared != identity (got %v)", c.Plane, r)
+		}
+
+		if det := m.Det(); !FloatEqualThreshold(det, -1, 1e-5) {
+			t.Errorf("ReflectionMatrix(%v) has determinant %v, expected -1", c.Plane, det)
+		}
+	}
+}
+
+func TestUpAxisConversion(t *testing.T) {
+	tests := []struct {
+		Point, Expected Vec3
+	}{
+		{Vec3{0, 1, 0}, Vec3{0, 0, 1}},
+		{Vec3{0, 0, 1}, Vec3{0, -1, 0}},
+		{Vec3{1, 0, 0}, Vec3{1, 0, 0}},
+		{Vec3{1, 2, 3}, Vec3{1, -3, 2}},
+	}
+
+	for _, c := range tests {
+		if r := TransformCoordinate(c.Point, YUpToZUp()); !r.EqualThreshold(c.Expected, 1e-6) {
+			t.Errorf("YUpToZUp maps %v to %v, expected %v", c.Point, r, c.Expected)
+		}
+		if r := TransformCoordinate(c.Expected, ZUpToYUp()); !r.EqualThreshold(c.Point, 1e-6) {
+			t.Errorf("ZUpToYUp maps %v to %v, expected %v", c.Expected, r, c.Point)
+		}
+	}
+
+	if r := YUpToZUp().Mul4(ZUpToYUp()); r != Ident4() {
+		t.Errorf("YUpToZUp * ZUpToYUp != identity (got %v)", r)
+	}
+	if det := YUpToZUp().Det(); det != 1 {
+		t.Errorf("YUpToZUp has determinant %v, expected 1", det)
+	}
+
+	if v, r := (Vec3{1, 2, 3}), (Vec3{1, 3, 2}); v.SwapYZ() != r {
+		t.Errorf("%v.SwapYZ() != %v (got %v)", v, r, v.SwapYZ())
+	}
+}
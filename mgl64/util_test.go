@@ -217,6 +217,22 @@ func TestClampf(t *testing.T) {
 	}
 }
 
+func TestSaturate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In, Expected float64
+	}{
+		{-1, 0}, {0, 0}, {0.25, 0.25}, {1, 1}, {1.5, 1},
+	}
+
+	for _, c := range tests {
+		if r := Saturate(c.In); r != c.Expected {
+			t.Errorf("Saturate(%v) != %v (got %v)", c.In, c.Expected, r)
+		}
+	}
+}
+
 func TestIsClamped(t *testing.T) {
 	t.Parallel()
 
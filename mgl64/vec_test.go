@@ -7,6 +7,8 @@
 package mgl64
 
 import (
+	"math"
+	"math/big"
 	"math/rand"
 	"testing"
 	"time"
@@ -20,13 +22,13 @@ func Test2DVecAdd(t *testing.T) {
 
 	v3 := v1.Add(v2)
 
-	if !FloatEqual(v3[0], 1.0) || !FloatEqual(v3[1], 3.5) {
+	if !v3.EqualThreshold(Vec2{1.0, 3.5}, 1e-5) {
 		t.Errorf("Add not adding properly")
 	}
 
 	v4 := v2.Add(v1)
 
-	if !FloatEqual(v3[0], v4[0]) || !FloatEqual(v3[1], v4[1]) {
+	if !v3.EqualThreshold(v4, 1e-5) {
 		t.Errorf("Addition is somehow not commutative")
 	}
 
@@ -38,13 +40,13 @@ func Test3DVecAdd(t *testing.T) {
 
 	v3 := v1.Add(v2)
 
-	if !FloatEqual(v3[0], 1.0) || !FloatEqual(v3[1], 3.5) || !FloatEqual(v3[2], 11.0) {
+	if !v3.EqualThreshold(Vec3{1.0, 3.5, 11.0}, 1e-5) {
 		t.Errorf("Add not adding properly")
 	}
 
 	v4 := v2.Add(v1)
 
-	if !FloatEqual(v3[0], v4[0]) || !FloatEqual(v3[1], v4[1]) || !FloatEqual(v3[2], v4[2]) {
+	if !v3.EqualThreshold(v4, 1e-5) {
 		t.Errorf("Addition is somehow not commutative")
 	}
 
@@ -56,13 +58,13 @@ func Test4DVecAdd(t *testing.T) {
 
 	v3 := v1.Add(v2)
 
-	if !FloatEqual(v3[0], 1.0) || !FloatEqual(v3[1], 3.5) || !FloatEqual(v3[2], 11.0) || !FloatEqual(v3[3], 102.0) {
+	if !v3.EqualThreshold(Vec4{1.0, 3.5, 11.0, 102.0}, 1e-5) {
 		t.Errorf("Add not adding properly")
 	}
 
 	v4 := v2.Add(v1)
 
-	if !FloatEqual(v3[0], v4[0]) || !FloatEqual(v3[1], v4[1]) || !FloatEqual(v3[2], v4[2]) || !FloatEqual(v3[3], v4[3]) {
+	if !v3.EqualThreshold(v4, 1e-5) {
 		t.Errorf("Addition is somehow not commutative")
 	}
 
@@ -74,7 +76,7 @@ func Test2DVecSub(t *testing.T) {
 
 	v3 := v1.Sub(v2)
 
-	if !FloatEqual(v3[0], 1.0) || !FloatEqual(v3[1], 1.5) {
+	if !v3.EqualThreshold(Vec2{1.0, 1.5}, 1e-5) {
 		t.Errorf("Sub not subtracting properly [%f, %f]", v3[0], v3[1])
 	}
 
@@ -86,8 +88,7 @@ func Test3DVecSub(t *testing.T) {
 
 	v3 := v1.Sub(v2)
 
-	// 1.1-9.9 does stupid things to floats, so we need threshold
-	if !FloatEqual(v3[0], 1.0) || !FloatEqual(v3[1], 1.5) || !FloatEqualThreshold(v3[2], -8.8, 1e-5) {
+	if !v3.EqualThreshold(Vec3{1.0, 1.5, -8.8}, 1e-5) {
 		t.Errorf("Sub not subtracting properly [%f, %f, %f]", v3[0], v3[1], v3[2])
 	}
 
@@ -99,8 +100,7 @@ func Test4DVecSub(t *testing.T) {
 
 	v3 := v1.Sub(v2)
 
-	// 1.1-9.9 does stupid things to floats, so we need a more tolerant threshold
-	if !FloatEqual(v3[0], 1.0) || !FloatEqual(v3[1], 1.5) || !FloatEqualThreshold(v3[2], -8.8, 1e-5) || !FloatEqual(v3[3], -98.0) {
+	if !v3.EqualThreshold(Vec4{1.0, 1.5, -8.8, -98.0}, 1e-5) {
 		t.Errorf("Sub not subtracting properly [%f, %f, %f, %f]", v3[0], v3[1], v3[2], v3[3])
 	}
 
@@ -110,21 +110,21 @@ func TestVecMul(t *testing.T) {
 	v := Vec2{1.0, 0.0}
 	v = v.Mul(15.0)
 
-	if !FloatEqual(v[0], 15.0) || !FloatEqual(v[1], 0.0) {
+	if !v.EqualThreshold(Vec2{15.0, 0.0}, 1e-5) {
 		t.Errorf("Vec mul does something weird [%f, %f]", v[0], v[1])
 	}
 
 	v2 := Vec3{1.0, 0.0, 100.1}
 	v2 = v2.Mul(15.0)
 
-	if !FloatEqual(v2[0], 15.0) || !FloatEqual(v2[1], 0.0) || !FloatEqual(v2[2], 1501.5) {
+	if !v2.EqualThreshold(Vec3{15.0, 0.0, 1501.5}, 1e-3) {
 		t.Errorf("Vec mul does something weird [%f, %f, %f]", v2[0], v2[1], v2[2])
 	}
 
 	v3 := Vec4{1.0, 0.0, 100.1, -1.0}
 	v3 = v3.Mul(15.0)
 
-	if !FloatEqual(v3[0], 15.0) || !FloatEqual(v3[1], 0.0) || !FloatEqual(v3[2], 1501.5) || !FloatEqual(v3[3], -15.0) {
+	if !v3.EqualThreshold(Vec4{15.0, 0.0, 1501.5, -15.0}, 1e-3) {
 		t.Errorf("Vec mul does something weird [%f, %f, %f, %f]", v3[0], v3[1], v3[2], v3[3])
 	}
 }
@@ -158,6 +158,52 @@ func TestVecCrossProduct(t *testing.T) {
 	}
 }
 
+func TestVecCrossNormalized(t *testing.T) {
+	tests := []struct {
+		V1, V2   Vec3
+		Expected Vec3
+	}{
+		{Vec3{1, 0, 0}, Vec3{0, 1, 0}, Vec3{0, 0, 1}},
+		{Vec3{0, 5, 0}, Vec3{3, 0, 0}, Vec3{0, 0, -1}},
+		{Vec3{2, 0, 0}, Vec3{0, 0, 7}, Vec3{0, -1, 0}},
+		{Vec3{1, 2, 3}, Vec3{10, 11, 12}, Vec3{-9, 18, -9}.Normalize()},
+		{Vec3{1, 2, 3}, Vec3{2, 4, 6}, Vec3{0, 0, 0}},
+		{Vec3{1, 2, 3}, Vec3{-1, -2, -3}, Vec3{0, 0, 0}},
+		{Vec3{1, 2, 3}, Vec3{0, 0, 0}, Vec3{0, 0, 0}},
+	}
+
+	for _, c := range tests {
+		if r := c.V1.CrossNormalized(c.V2); !r.ApproxEqualThreshold(c.Expected, 1e-6) {
+			t.Errorf("Vec3(%v).CrossNormalized(%v) != %v (got %v)", c.V1, c.V2, c.Expected, r)
+		}
+	}
+}
+
+func TestVecPointDirection(t *testing.T) {
+	v := Vec3{1, 2, 3}
+	m := Translate3D(10, 20, 30)
+
+	if p := v.Point(); p != (Vec4{1, 2, 3, 1}) {
+		t.Errorf("Vec3(%v).Point() != %v (got %v)", v, Vec4{1, 2, 3, 1}, p)
+	}
+
+	if d := v.Direction(); d != (Vec4{1, 2, 3, 0}) {
+		t.Errorf("Vec3(%v).Direction() != %v (got %v)", v, Vec4{1, 2, 3, 0}, d)
+	}
+
+	if r := m.Mul4x1(v.Point()).ToVec3Perspective(); !r.ApproxEqual(Vec3{11, 22, 33}) {
+		t.Errorf("Translated point %v != %v (got %v)", v, Vec3{11, 22, 33}, r)
+	}
+
+	if r := m.Mul4x1(v.Direction()).Vec3(); !r.ApproxEqual(v) {
+		t.Errorf("Translated direction %v != %v (got %v)", v, v, r)
+	}
+
+	if r := (Vec4{2, 4, 6, 2}).ToVec3Perspective(); !r.ApproxEqual(Vec3{1, 2, 3}) {
+		t.Errorf("Vec4(%v).ToVec3Perspective() != %v (got %v)", Vec4{2, 4, 6, 2}, Vec3{1, 2, 3}, r)
+	}
+}
+
 func TestVecDotProduct(t *testing.T) {
 	mustEqual := func(result float64, expected float64, name string) {
 		if !FloatEqual(result, expected) {
@@ -184,6 +230,28 @@ func TestVecLen(t *testing.T) {
 	mustEqual(Vec4{2, 3, 5, 7}.Len(), 9.3273790530888, "Vec4.Len()")
 }
 
+func TestVecManhattanChebyshev(t *testing.T) {
+	mustEqual := func(result float64, expected float64, name string) {
+		if result != expected {
+			t.Errorf("%v failed. Got: %v. Expected %v.",
+				name, result, expected)
+		}
+	}
+
+	mustEqual(Vec2{3, -4}.LenManhattan(), 7, "Vec2.LenManhattan()")
+	mustEqual(Vec2{3, -4}.LenChebyshev(), 4, "Vec2.LenChebyshev()")
+	mustEqual(Vec3{-5, 2, 4}.LenManhattan(), 11, "Vec3.LenManhattan()")
+	mustEqual(Vec3{-5, 2, 4}.LenChebyshev(), 5, "Vec3.LenChebyshev()")
+	mustEqual(Vec4{1, -2, 3, -7}.LenManhattan(), 13, "Vec4.LenManhattan()")
+	mustEqual(Vec4{1, -2, 3, -7}.LenChebyshev(), 7, "Vec4.LenChebyshev()")
+
+	mustEqual(Vec2{1, 1}.DistanceManhattan(Vec2{4, -3}), 7, "Vec2.DistanceManhattan()")
+	mustEqual(Vec2{1, 1}.DistanceChebyshev(Vec2{4, -3}), 4, "Vec2.DistanceChebyshev()")
+	mustEqual(Vec3{1, 2, 3}.DistanceManhattan(Vec3{0, 4, -3}), 9, "Vec3.DistanceManhattan()")
+	mustEqual(Vec3{1, 2, 3}.DistanceChebyshev(Vec3{0, 4, -3}), 6, "Vec3.DistanceChebyshev()")
+	mustEqual(Vec3{1, 2, 3}.DistanceManhattan(Vec3{1, 2, 3}), 0, "Vec3.DistanceManhattan() to self")
+}
+
 func Test2DVecNormalize(t *testing.T) {
 	v := Vec2{3, 4}
 	norm := v.Normalize()
@@ -223,6 +291,298 @@ func TestVecElemAccessors(t *testing.T) {
 	mustEqual("Vec.Elem() -> w", w, w4)
 }
 
+func TestVecIndexAccessors(t *testing.T) {
+	mustPanic := func(desc string, f func()) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("%v did not panic", desc)
+			}
+		}()
+		f()
+	}
+
+	v2 := Vec2{1, 2}
+	v3 := Vec3{1, 2, 3}
+	v4 := Vec4{1, 2, 3, 4}
+
+	if v2.N() != 2 || v3.N() != 3 || v4.N() != 4 {
+		t.Errorf("Vec.N() failed. Got: %v, %v, %v", v2.N(), v3.N(), v4.N())
+	}
+
+	for i := 0; i < 4; i++ {
+		if i < 2 && v2.At(i) != v2[i] {
+			t.Errorf("Vec2.At(%v) != %v (got %v)", i, v2[i], v2.At(i))
+		}
+		if i < 3 && v3.At(i) != v3[i] {
+			t.Errorf("Vec3.At(%v) != %v (got %v)", i, v3[i], v3.At(i))
+		}
+		if v4.At(i) != v4[i] {
+			t.Errorf("Vec4.At(%v) != %v (got %v)", i, v4[i], v4.At(i))
+		}
+	}
+
+	v3.Set(1, 5)
+	if v3 != (Vec3{1, 5, 3}) {
+		t.Errorf("Vec3.Set(1, 5) failed. Got: %v", v3)
+	}
+	v2.Set(0, -1)
+	if v2 != (Vec2{-1, 2}) {
+		t.Errorf("Vec2.Set(0, -1) failed. Got: %v", v2)
+	}
+	v4.Set(3, 7)
+	if v4 != (Vec4{1, 2, 3, 7}) {
+		t.Errorf("Vec4.Set(3, 7) failed. Got: %v", v4)
+	}
+
+	mustPanic("Vec2.At(2)", func() { v2.At(2) })
+	mustPanic("Vec3.At(-1)", func() { v3.At(-1) })
+	mustPanic("Vec3.At(3)", func() { v3.At(3) })
+	mustPanic("Vec4.At(4)", func() { v4.At(4) })
+	mustPanic("Vec2.Set(-1)", func() { v2.Set(-1, 0) })
+	mustPanic("Vec3.Set(3)", func() { v3.Set(3, 0) })
+	mustPanic("Vec4.Set(4)", func() { v4.Set(4, 0) })
+}
+
+func TestVecSaturate(t *testing.T) {
+	if r := (Vec2{-0.5, 1.5}).Saturate(); r != (Vec2{0, 1}) {
+		t.Errorf("Vec2.Saturate() != %v (got %v)", Vec2{0, 1}, r)
+	}
+
+	if r := (Vec3{-2, 0.5, 3}).Saturate(); r != (Vec3{0, 0.5, 1}) {
+		t.Errorf("Vec3.Saturate() != %v (got %v)", Vec3{0, 0.5, 1}, r)
+	}
+
+	if r := (Vec4{0, 1, -0.1, 1.1}).Saturate(); r != (Vec4{0, 1, 0, 1}) {
+		t.Errorf("Vec4.Saturate() != %v (got %v)", Vec4{0, 1, 0, 1}, r)
+	}
+}
+
+func TestVecMulAdd(t *testing.T) {
+	if r, e := (Vec2{1, -2}).MulAdd(3, Vec2{0.5, 1}), (Vec2{3.5, -5}); r != e {
+		t.Errorf("Vec2.MulAdd() != %v (got %v)", e, r)
+	}
+
+	if r, e := (Vec3{1, 2, 3}).MulAdd(-2, Vec3{4, 4, 4}), (Vec3{2, 0, -2}); r != e {
+		t.Errorf("Vec3.MulAdd() != %v (got %v)", e, r)
+	}
+
+	if r, e := (Vec4{1, 2, 3, 4}).MulAdd(0.5, Vec4{1, 1, 1, 1}), (Vec4{1.5, 2, 2.5, 3}); r != e {
+		t.Errorf("Vec4.MulAdd() != %v (got %v)", e, r)
+	}
+
+	// With v = s = 1+d and addend = -(1+2d) the exact result is d*d. If d*d is
+	// below half an ulp of 1, rounding the product first (as separate operations
+	// do) loses it entirely, while the fused operation keeps it.
+	eps := float64(1)
+	for 1+eps/2 != 1 {
+		eps /= 2
+	}
+	d := float64(1)
+	for d*d > eps/4 {
+		d /= 2
+	}
+
+	v, s, addend := Vec3{1 + d, 1, 0}, 1+d, Vec3{-(1 + 2*d), 0, 0}
+	if r, e := v.MulAdd(s, addend), (Vec3{d * d, 1 + d, 0}); r != e {
+		t.Errorf("%v.MulAdd(%v, %v) != %v (got %v)", v, s, addend, e, r)
+	}
+
+	// The explicit conversion forces the product to be rounded, see the Go spec on
+	// fused floating-point operations.
+	if r := float64(v[0]*s) + addend[0]; r != 0 {
+		t.Errorf("separately rounded v*s + addend unexpectedly kept the low-order bits (got %v)", r)
+	}
+}
+
+func TestVecEqualThreshold(t *testing.T) {
+	tests := []struct {
+		V1, V2   Vec3
+		Eps      float64
+		Expected bool
+	}{
+		{Vec3{1, 2, 3}, Vec3{1, 2, 3}, 0, true},
+		{Vec3{1, 2, 3}, Vec3{1.000001, 1.999999, 3}, 1e-5, true},
+		{Vec3{0, 0, 0}, Vec3{1e-7, -1e-7, 0}, 1e-6, true},
+		{Vec3{1, 2, 3}, Vec3{1, 2, 3.1}, 1e-5, false},
+		{Vec3{1, 2, 3}, Vec3{-1, -2, -3}, 1, false},
+		{Vec3{1000, 0, 0}, Vec3{1000.5, 0, 0}, 1, true},
+	}
+
+	for _, c := range tests {
+		if r := c.V1.EqualThreshold(c.V2, c.Eps); r != c.Expected {
+			t.Errorf("%v.EqualThreshold(%v, %v) != %v (got %v)", c.V1, c.V2, c.Eps, c.Expected, r)
+		}
+		if r := VecEqualThreshold(c.V1, c.V2, c.Eps); r != c.Expected {
+			t.Errorf("VecEqualThreshold(%v, %v, %v) != %v (got %v)", c.V1, c.V2, c.Eps, c.Expected, r)
+		}
+	}
+
+	if !(Vec2{0, 1}).EqualThreshold(Vec2{1e-6, 1}, 1e-5) || (Vec2{0, 1}).EqualThreshold(Vec2{0, 1.1}, 1e-5) {
+		t.Errorf("Vec2.EqualThreshold failed")
+	}
+	if !(Vec4{0, 1, 2, 3}).EqualThreshold(Vec4{0, 1, 2, 3 + 1e-6}, 1e-5) || (Vec4{0, 1, 2, 3}).EqualThreshold(Vec4{0, 1, 2, 4}, 1e-5) {
+		t.Errorf("Vec4.EqualThreshold failed")
+	}
+}
+
+func TestVec2Rotate(t *testing.T) {
+	tests := []struct {
+		V        Vec2
+		Angle    float64
+		Expected Vec2
+	}{
+		{Vec2{1, 0}, math.Pi / 2, Vec2{0, 1}},
+		{Vec2{1, 0}, math.Pi, Vec2{-1, 0}},
+		{Vec2{0, 2}, -math.Pi / 2, Vec2{2, 0}},
+		{Vec2{1, 1}, math.Pi / 4, Vec2{0, float64(math.Sqrt2)}},
+		{Vec2{3, -4}, 0, Vec2{3, -4}},
+	}
+
+	for _, c := range tests {
+		if r := c.V.Rotate(c.Angle); !r.EqualThreshold(c.Expected, 1e-6) {
+			t.Errorf("%v.Rotate(%v) != %v (got %v)", c.V, c.Angle, c.Expected, r)
+		}
+	}
+}
+
+func TestVec2Perp(t *testing.T) {
+	for _, v := range []Vec2{{1, 0}, {0, 1}, {3, -4}} {
+		p := v.Perp()
+		if p.Dot(v) != 0 || p.Len() != v.Len() {
+			t.Errorf("%v.Perp() is not a perpendicular vector of the same length (got %v)", v, p)
+		}
+		if r := v.Rotate(math.Pi / 2); !p.EqualThreshold(r, 1e-6) {
+			t.Errorf("%v.Perp() != %v (got %v)", v, r, p)
+		}
+	}
+
+	if r := (Vec2{1, 0}).Perp(); r != (Vec2{0, 1}) {
+		t.Errorf("Vec2{1, 0}.Perp() != %v (got %v)", Vec2{0, 1}, r)
+	}
+}
+
+func TestVec2Cross(t *testing.T) {
+	tests := []struct {
+		V1, V2   Vec2
+		Expected float64
+	}{
+		{Vec2{1, 0}, Vec2{0, 1}, 1},
+		{Vec2{0, 1}, Vec2{1, 0}, -1},
+		{Vec2{2, 0}, Vec2{0, -3}, -6},
+		{Vec2{1, 2}, Vec2{2, 4}, 0},
+		{Vec2{1, 2}, Vec2{-3, -6}, 0},
+		{Vec2{3, 1}, Vec2{1, 2}, 5},
+	}
+
+	for _, c := range tests {
+		if r := c.V1.Cross(c.V2); r != c.Expected {
+			t.Errorf("%v.Cross(%v) != %v (got %v)", c.V1, c.V2, c.Expected, r)
+		}
+		if r, e := c.V1.Vec3(0).Cross(c.V2.Vec3(0)), (Vec3{0, 0, c.Expected}); r != e {
+			t.Errorf("Vec2.Cross doesn't match the 3D cross product %v (got %v)", e, r)
+		}
+	}
+}
+
+func TestVec2CrossScalar(t *testing.T) {
+	v := Vec2{3, -2}
+	if r, e := v.CrossScalar(2), (Vec2{-4, -6}); r != e {
+		t.Errorf("%v.CrossScalar(2) != %v (got %v)", v, e, r)
+	}
+
+	// Matches the 3D cross product with a vector along Z
+	if r, e := v.CrossScalar(2), v.Vec3(0).Cross(Vec3{0, 0, 2}); r != e.Vec2() {
+		t.Errorf("%v.CrossScalar(2) != %v (got %v)", v, e.Vec2(), r)
+	}
+
+	// Velocity of a point on a body spinning counterclockwise is perpendicular to it
+	if r := v.CrossScalar(-1); r != v.Perp() {
+		t.Errorf("%v.CrossScalar(-1) != %v (got %v)", v, v.Perp(), r)
+	}
+}
+
+func TestVec3Refract(t *testing.T) {
+	normal := Vec3{0, 1, 0}
+	incoming := func(angle float64) Vec3 {
+		return Vec3{float64(math.Sin(angle)), -float64(math.Cos(angle)), 0}
+	}
+
+	// Head on, the ray passes straight through
+	if r, ok := incoming(0).RefractIOR(normal, 1, 1.33); !ok || !r.EqualThreshold(Vec3{0, -1, 0}, 1e-6) {
+		t.Errorf("RefractIOR head on != %v, true (got %v, %v)", Vec3{0, -1, 0}, r, ok)
+	}
+
+	// Snell's law: n1 sin(a1) = n2 sin(a2), and the result stays normalized
+	for _, c := range []struct{ N1, N2, Angle float64 }{{1, 1.33, 0.6}, {1.5, 1, 0.3}, {1, 1, 1.2}} {
+		v := incoming(float64(c.Angle))
+		r, ok := v.RefractIOR(normal, c.N1, c.N2)
+		if !ok {
+			t.Errorf("RefractIOR(%v, %v, %v) was total internal reflection", v, c.N1, c.N2)
+			continue
+		}
+		if !FloatEqualThreshold(r.Len(), 1, 1e-5) || r[1] >= 0 {
+			t.Errorf("RefractIOR(%v, %v, %v) = %v is not a unit vector through the surface", v, c.N1, c.N2, r)
+		}
+		if e := c.N1 / c.N2 * v[0]; Abs(r[0]-e) > 1e-5 {
+			t.Errorf("RefractIOR(%v, %v, %v) = %v does not obey Snell's law (expected sin %v)", v, c.N1, c.N2, r, e)
+		}
+	}
+
+	// From glass into air, the critical angle is asin(1/1.5)
+	critical := math.Asin(1 / 1.5)
+	if r, ok := incoming(critical-1e-3).RefractIOR(normal, 1.5, 1); !ok || Abs(r[1]) > 0.1 {
+		t.Errorf("RefractIOR just below the critical angle != grazing ray (got %v, %v)", r, ok)
+	}
+	if r, ok := incoming(critical+1e-3).RefractIOR(normal, 1.5, 1); ok || r != (Vec3{}) {
+		t.Errorf("RefractIOR just above the critical angle != total internal reflection (got %v, %v)", r, ok)
+	}
+}
+
+func TestVecParallelPerpendicular(t *testing.T) {
+	tests3 := []struct {
+		Description             string
+		V1, V2                  Vec3
+		Parallel, Perpendicular bool
+	}{
+		{"parallel", Vec3{1, 2, 3}, Vec3{2, 4, 6}, true, false},
+		{"antiparallel", Vec3{1, 2, 3}, Vec3{-0.5, -1, -1.5}, true, false},
+		{"perpendicular", Vec3{1, 1, 0}, Vec3{-2, 2, 5}, false, true},
+		{"neither", Vec3{1, 0, 0}, Vec3{1, 1, 0}, false, false},
+		{"nearly parallel", Vec3{1, 0, 0}, Vec3{1, 1e-5, 0}, true, false},
+		{"zero", Vec3{}, Vec3{1, 2, 3}, false, false},
+	}
+
+	for _, c := range tests3 {
+		if r := c.V1.IsParallel(c.V2, 1e-4); r != c.Parallel {
+			t.Errorf("%v failed: %v.IsParallel(%v) != %v", c.Description, c.V1, c.V2, c.Parallel)
+		}
+		if r := c.V1.IsPerpendicular(c.V2, 1e-4); r != c.Perpendicular {
+			t.Errorf("%v failed: %v.IsPerpendicular(%v) != %v", c.Description, c.V1, c.V2, c.Perpendicular)
+		}
+	}
+
+	tests2 := []struct {
+		Description             string
+		V1, V2                  Vec2
+		Parallel, Perpendicular bool
+	}{
+		{"parallel", Vec2{1, 2}, Vec2{3, 6}, true, false},
+		{"antiparallel", Vec2{1, 2}, Vec2{-1, -2}, true, false},
+		{"perpendicular", Vec2{1, 2}, Vec2{-4, 2}, false, true},
+		{"neither", Vec2{1, 0}, Vec2{1, 1}, false, false},
+		{"zero", Vec2{1, 2}, Vec2{}, false, false},
+	}
+
+	for _, c := range tests2 {
+		if r := c.V1.IsParallel(c.V2, 1e-4); r != c.Parallel {
+			t.Errorf("%v failed: %v.IsParallel(%v) != %v", c.Description, c.V1, c.V2, c.Parallel)
+		}
+		if r := c.V1.IsPerpendicular(c.V2, 1e-4); r != c.Perpendicular {
+			t.Errorf("%v failed: %v.IsPerpendicular(%v) != %v", c.Description, c.V1, c.V2, c.Perpendicular)
+		}
+	}
+}
+
 func TestVecEqual(t *testing.T) {
 	assert := func(res bool, desc string) {
 		if !res {
@@ -356,3 +716,52 @@ func BenchmarkVecCross(b *testing.B) {
 		v1.Cross(v2)
 	}
 }
+
+func TestCrossStable(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	// Machine epsilon of the package's float type
+	eps := float64(1)
+	for 1+eps/2 != 1 {
+		eps /= 2
+	}
+
+	// exact computes a*b - c*d with enough precision to be exact before rounding.
+	exact := func(a, b, c, d float64) float64 {
+		p := new(big.Float).SetPrec(256).Mul(big.NewFloat(float64(a)), big.NewFloat(float64(b)))
+		q := new(big.Float).SetPrec(256).Mul(big.NewFloat(float64(c)), big.NewFloat(float64(d)))
+		f, _ := p.Sub(p, q).Float64()
+		return f
+	}
+
+	var stableErr, naiveErr float64
+	for i := 0; i < 1000; i++ {
+		a := RandomVec3(r, 10)
+		b := a.Add(RandomVec3(r, 1e-3)).Mul(1 + r.Float64())
+
+		e := Vec3{
+			exact(a[1], b[2], a[2], b[1]),
+			exact(a[2], b[0], a[0], b[2]),
+			exact(a[0], b[1], a[1], b[0]),
+		}
+
+		s := CrossStable(a, b)
+		for j := range e {
+			if Abs(s[j]-e[j]) > eps*Abs(e[j]) {
+				t.Errorf("CrossStable(%v, %v) != %v (got %v)", a, b, e, s)
+				break
+			}
+		}
+
+		stableErr += s.Sub(e).Len() / e.Len()
+		naiveErr += a.Cross(b).Sub(e).Len() / e.Len()
+	}
+
+	if stableErr >= naiveErr {
+		t.Errorf("CrossStable is no more accurate than Cross on nearly parallel vectors (relative error %v, Cross %v)", stableErr, naiveErr)
+	}
+
+	if a, b, e := (Vec3{1, 0, 0}), (Vec3{0, 1, 0}), (Vec3{0, 0, 1}); CrossStable(a, b) != e {
+		t.Errorf("CrossStable(%v, %v) != %v (got %v)", a, b, e, CrossStable(a, b))
+	}
+}
@@ -35,6 +35,18 @@ func (v Vec3) Vec4(w float64) Vec4 {
 	return Vec4{v[0], v[1], v[2], w}
 }
 
+// Point converts the vector into a homogeneous point, that is, a Vec4 with w=1.
+// Points are affected by the translation part of a transformation matrix.
+func (v Vec3) Point() Vec4 {
+	return Vec4{v[0], v[1], v[2], 1}
+}
+
+// Direction converts the vector into a homogeneous direction, that is, a Vec4 with w=0.
+// Directions (and normals) are not affected by the translation part of a transformation matrix.
+func (v Vec3) Direction() Vec4 {
+	return Vec4{v[0], v[1], v[2], 0}
+}
+
 func (v Vec4) Vec2() Vec2 {
 	return Vec2{v[0], v[1]}
 }
@@ -43,6 +55,13 @@ func (v Vec4) Vec3() Vec3 {
 	return Vec3{v[0], v[1], v[2]}
 }
 
+// ToVec3Perspective converts a homogeneous vector back into 3D space by dividing
+// the x, y, and z components by w (the "perspective divide"). If w is 0, the
+// vector is a direction and the result will be infinite or NaN.
+func (v Vec4) ToVec3Perspective() Vec3 {
+	return Vec3{v[0] / v[3], v[1] / v[3], v[2] / v[3]}
+}
+
 // Elem extracts the elements of the vector for direct value assignment.
 func (v Vec2) Elem() (x, y float64) {
 	return v[0], v[1]
@@ -81,6 +100,149 @@ func (v1 Vec3) Cross(v2 Vec3) Vec3 {
 	return Vec3{v1[1]*v2[2] - v1[2]*v2[1], v1[2]*v2[0] - v1[0]*v2[2], v1[0]*v2[1] - v1[1]*v2[0]}
 }
 
+// CrossNormalized returns the normalized cross product of v1 and v2, which is the
+// unit normal of the plane the two vectors span. This is equivalent to
+// v1.Cross(v2).Normalize(), except that if v1 and v2 are parallel (or either of
+// them is zero) the zero vector is returned instead of a vector of NaNs or infinities.
+func (v1 Vec3) CrossNormalized(v2 Vec3) Vec3 {
+	c := v1.Cross(v2)
+	l := c.Len()
+	if l <= Epsilon {
+		return Vec3{}
+	}
+
+	return Vec3{c[0] / l, c[1] / l, c[2] / l}
+}
+
+// CrossStable returns the cross product of a and b like a.Cross(b), but computes each
+// element a[i]*b[j] - a[j]*b[i] with Kahan's fused multiply-add algorithm for the
+// difference of products. The naive formula cancels catastrophically when a and b are
+// nearly parallel, as with the edges of thin triangles, leaving few or no correct bits;
+// this version is accurate to within a couple of ulps in that case, at the cost of
+// being slower.
+func CrossStable(a, b Vec3) Vec3 {
+	return Vec3{
+		diffOfProducts(a[1], b[2], a[2], b[1]),
+		diffOfProducts(a[2], b[0], a[0], b[2]),
+		diffOfProducts(a[0], b[1], a[1], b[0]),
+	}
+}
+
+// Refract returns the direction of a ray travelling along v after it is refracted at
+// a surface with the given normal, where eta is the ratio of the refractive indices of
+// the medium the ray leaves and the one it enters (n1/n2). This is the same formula as
+// GLSL's refract: v and normal must be normalized, and normal must face against v.
+//
+// If the angle of incidence is beyond the critical angle the ray undergoes total
+// internal reflection; the zero vector and false are returned.
+func (v Vec3) Refract(normal Vec3, eta float64) (Vec3, bool) {
+	cosi := normal.Dot(v)
+	k := 1 - eta*eta*(1-cosi*cosi)
+	if k < 0 {
+		return Vec3{}, false
+	}
+
+	return v.Mul(eta).Sub(normal.Mul(eta*cosi + float64(math.Sqrt(float64(k))))), true
+}
+
+// RefractIOR is like Refract, but takes the refractive indices of the two media
+// directly: n1 for the medium the ray travels through and n2 for the one it enters
+// (for instance 1 for air and 1.33 for water).
+func (v Vec3) RefractIOR(normal Vec3, n1, n2 float64) (Vec3, bool) {
+	return v.Refract(normal, n1/n2)
+}
+
+// SwapYZ returns the vector with its Y and Z components exchanged. Unlike YUpToZUp,
+// this is a reflection rather than a rotation: it also flips the handedness of the
+// coordinate system, which is what converting between a right-handed Y-up system and
+// a left-handed Z-up one (or vice versa) requires.
+func (v Vec3) SwapYZ() Vec3 {
+	return Vec3{v[0], v[2], v[1]}
+}
+
+// IsParallel reports whether v1 and v2 point along the same line, in either the
+// same or opposite directions. The tolerance eps is compared against the sine of the
+// angle between them, |v1 x v2| / (|v1||v2|). Zero vectors are not parallel to anything.
+func (v1 Vec3) IsParallel(v2 Vec3, eps float64) bool {
+	l := v1.Len() * v2.Len()
+	if l == 0 {
+		return false
+	}
+
+	return v1.Cross(v2).Len() <= eps*l
+}
+
+// IsPerpendicular reports whether v1 and v2 are at right angles. The tolerance eps is
+// compared against the cosine of the angle between them, |v1.v2| / (|v1||v2|). Zero
+// vectors are not perpendicular to anything.
+func (v1 Vec3) IsPerpendicular(v2 Vec3, eps float64) bool {
+	l := v1.Len() * v2.Len()
+	if l == 0 {
+		return false
+	}
+
+	return Abs(v1.Dot(v2)) <= eps*l
+}
+
+// IsParallel reports whether v1 and v2 point along the same line, in either the
+// same or opposite directions. The tolerance eps is compared against the sine of the
+// angle between them. Zero vectors are not parallel to anything.
+func (v1 Vec2) IsParallel(v2 Vec2, eps float64) bool {
+	l := v1.Len() * v2.Len()
+	if l == 0 {
+		return false
+	}
+
+	return Abs(v1.Cross(v2)) <= eps*l
+}
+
+// IsPerpendicular reports whether v1 and v2 are at right angles. The tolerance eps is
+// compared against the cosine of the angle between them. Zero vectors are not
+// perpendicular to anything.
+func (v1 Vec2) IsPerpendicular(v2 Vec2, eps float64) bool {
+	l := v1.Len() * v2.Len()
+	if l == 0 {
+		return false
+	}
+
+	return Abs(v1.Dot(v2)) <= eps*l
+}
+
+// Cross returns the 2D cross product v1[0]*v2[1] - v1[1]*v2[0], which is the Z
+// component of the 3D cross product of v1 and v2 extended with z=0. Its magnitude is
+// |v1||v2|sin(theta), and it is positive if v2 is counterclockwise from v1.
+func (v1 Vec2) Cross(v2 Vec2) float64 {
+	return v1[0]*v2[1] - v1[1]*v2[0]
+}
+
+// CrossScalar returns the cross product of the vector with a scalar, treating the
+// scalar as a vector s along Z: v x s = (s*v[1], -s*v[0]). The opposite order,
+// s x v, is the negation of this, and gives the linear velocity of a point at offset
+// v from the center of a body rotating with angular velocity s.
+func (v Vec2) CrossScalar(s float64) Vec2 {
+	return Vec2{s * v[1], -s * v[0]}
+}
+
+// Rotate rotates the vector counterclockwise by angle radians (assuming X points right
+// and Y points up).
+func (v Vec2) Rotate(angle float64) Vec2 {
+	sin, cos := math.Sincos(float64(angle))
+	s, c := float64(sin), float64(cos)
+	return Vec2{v[0]*c - v[1]*s, v[0]*s + v[1]*c}
+}
+
+// Perp returns the vector rotated counterclockwise by 90 degrees, (-y, x). It is
+// exact, unlike v.Rotate(math.Pi/2).
+func (v Vec2) Perp() Vec2 {
+	return Vec2{-v[1], v[0]}
+}
+
+// VecEqualThreshold reports whether every element of v1 is within eps of the
+// corresponding element of v2. It is equivalent to v1.EqualThreshold(v2, eps).
+func VecEqualThreshold(v1, v2 Vec3, eps float64) bool {
+	return v1.EqualThreshold(v2, eps)
+}
+
 // Add performs element-wise addition between two vectors. It is equivalent to iterating
 // over every element of v1 and adding the corresponding element of v2 to it.
 func (v1 Vec2) Add(v2 Vec2) Vec2 {
@@ -126,6 +288,38 @@ func (v1 Vec2) Len() float64 {
 
 }
 
+// LenManhattan returns the vector's Manhattan (taxicab, or L1) length, the sum
+// of the absolute values of its elements.
+func (v1 Vec2) LenManhattan() float64 {
+	return Abs(v1[0]) + Abs(v1[1])
+}
+
+// LenChebyshev returns the vector's Chebyshev (chessboard, or L-infinity) length,
+// the largest absolute value of its elements.
+func (v1 Vec2) LenChebyshev() float64 {
+	l := Abs(v1[0])
+	for _, e := range v1[1:] {
+		if Abs(e) > l {
+			l = Abs(e)
+		}
+	}
+	return l
+}
+
+// DistanceManhattan returns the Manhattan distance between v1 and v2, the
+// number of unit steps along the axes needed to get from one to the other.
+// It is equivalent to v1.Sub(v2).LenManhattan().
+func (v1 Vec2) DistanceManhattan(v2 Vec2) float64 {
+	return v1.Sub(v2).LenManhattan()
+}
+
+// DistanceChebyshev returns the Chebyshev distance between v1 and v2, the
+// largest difference along any single axis. It is equivalent to
+// v1.Sub(v2).LenChebyshev().
+func (v1 Vec2) DistanceChebyshev(v2 Vec2) float64 {
+	return v1.Sub(v2).LenChebyshev()
+}
+
 // Normalize normalizes the vector. Normalization is (1/|v|)*v,
 // making this equivalent to v.Scale(1/v.Len()). If the len is 0.0,
 // this function will return an infinite value for all elements due
@@ -173,6 +367,18 @@ func (v1 Vec2) ApproxFuncEqual(v2 Vec2, eq func(float64, float64) bool) bool {
 	return true
 }
 
+// EqualThreshold reports whether every element of v1 is within eps of the corresponding
+// element of v2. Unlike ApproxEqualThreshold this is an absolute tolerance, so it behaves
+// predictably for elements that are at or near zero.
+func (v1 Vec2) EqualThreshold(v2 Vec2, eps float64) bool {
+	for i := range v1 {
+		if Abs(v1[i]-v2[i]) > eps {
+			return false
+		}
+	}
+	return true
+}
+
 // This is an element access func, it is equivalent to v[n] where
 // n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
 // show that this is more or less as fast as direct acces, probably due to
@@ -189,6 +395,47 @@ func (v Vec2) Y() float64 {
 	return v[1]
 }
 
+// MulAdd returns v*s + addend, computing each element with a single fused
+// multiply-add (math.FMA) so that the product is not rounded before the addition.
+// This is both more convenient and more accurate than v.Mul(s).Add(addend) in
+// accumulation loops.
+func (v Vec2) MulAdd(s float64, addend Vec2) Vec2 {
+	return Vec2{
+		float64(math.FMA(float64(v[0]), float64(s), float64(addend[0]))),
+		float64(math.FMA(float64(v[1]), float64(s), float64(addend[1]))),
+	}
+}
+
+// Saturate clamps every element of the vector to the range [0,1], as if
+// Saturate had been called on each one.
+func (v Vec2) Saturate() Vec2 {
+	return Vec2{Saturate(v[0]), Saturate(v[1])}
+}
+
+// N returns the number of elements in the vector, i.e. 2.
+func (v Vec2) N() int {
+	return 2
+}
+
+// At returns the element at index i, where the mappings are XYZW (X=0, Y=1 etc).
+// Unlike direct indexing, this will panic with a descriptive message if i
+// is not in the range [0,2).
+func (v Vec2) At(i int) float64 {
+	if i < 0 || i >= 2 {
+		panic("Vec2.At: index out of range [0,2)")
+	}
+	return v[i]
+}
+
+// Set sets the element at index i to val. This has a pointer receiver because
+// it mutates the vector. It will panic if i is not in the range [0,2).
+func (v *Vec2) Set(i int, val float64) {
+	if i < 0 || i >= 2 {
+		panic("Vec2.Set: index out of range [0,2)")
+	}
+	v[i] = val
+}
+
 // Does the vector outer product
 // of two vectors. The outer product produces an
 // 2x2 matrix. E.G. a Vec2 * Vec2 = Mat2.
@@ -279,6 +526,38 @@ func (v1 Vec3) Len() float64 {
 
 }
 
+// LenManhattan returns the vector's Manhattan (taxicab, or L1) length, the sum
+// of the absolute values of its elements.
+func (v1 Vec3) LenManhattan() float64 {
+	return Abs(v1[0]) + Abs(v1[1]) + Abs(v1[2])
+}
+
+// LenChebyshev returns the vector's Chebyshev (chessboard, or L-infinity) length,
+// the largest absolute value of its elements.
+func (v1 Vec3) LenChebyshev() float64 {
+	l := Abs(v1[0])
+	for _, e := range v1[1:] {
+		if Abs(e) > l {
+			l = Abs(e)
+		}
+	}
+	return l
+}
+
+// DistanceManhattan returns the Manhattan distance between v1 and v2, the
+// number of unit steps along the axes needed to get from one to the other.
+// It is equivalent to v1.Sub(v2).LenManhattan().
+func (v1 Vec3) DistanceManhattan(v2 Vec3) float64 {
+	return v1.Sub(v2).LenManhattan()
+}
+
+// DistanceChebyshev returns the Chebyshev distance between v1 and v2, the
+// largest difference along any single axis. It is equivalent to
+// v1.Sub(v2).LenChebyshev().
+func (v1 Vec3) DistanceChebyshev(v2 Vec3) float64 {
+	return v1.Sub(v2).LenChebyshev()
+}
+
 // Normalize normalizes the vector. Normalization is (1/|v|)*v,
 // making this equivalent to v.Scale(1/v.Len()). If the len is 0.0,
 // this function will return an infinite value for all elements due
@@ -326,6 +605,18 @@ func (v1 Vec3) ApproxFuncEqual(v2 Vec3, eq func(float64, float64) bool) bool {
 	return true
 }
 
+// EqualThreshold reports whether every element of v1 is within eps of the corresponding
+// element of v2. Unlike ApproxEqualThreshold this is an absolute tolerance, so it behaves
+// predictably for elements that are at or near zero.
+func (v1 Vec3) EqualThreshold(v2 Vec3, eps float64) bool {
+	for i := range v1 {
+		if Abs(v1[i]-v2[i]) > eps {
+			return false
+		}
+	}
+	return true
+}
+
 // This is an element access func, it is equivalent to v[n] where
 // n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
 // show that this is more or less as fast as direct acces, probably due to
@@ -350,6 +641,48 @@ func (v Vec3) Z() float64 {
 	return v[2]
 }
 
+// MulAdd returns v*s + addend, computing each element with a single fused
+// multiply-add (math.FMA) so that the product is not rounded before the addition.
+// This is both more convenient and more accurate than v.Mul(s).Add(addend) in
+// accumulation loops.
+func (v Vec3) MulAdd(s float64, addend Vec3) Vec3 {
+	return Vec3{
+		float64(math.FMA(float64(v[0]), float64(s), float64(addend[0]))),
+		float64(math.FMA(float64(v[1]), float64(s), float64(addend[1]))),
+		float64(math.FMA(float64(v[2]), float64(s), float64(addend[2]))),
+	}
+}
+
+// Saturate clamps every element of the vector to the range [0,1], as if
+// Saturate had been called on each one.
+func (v Vec3) Saturate() Vec3 {
+	return Vec3{Saturate(v[0]), Saturate(v[1]), Saturate(v[2])}
+}
+
+// N returns the number of elements in the vector, i.e. 3.
+func (v Vec3) N() int {
+	return 3
+}
+
+// At returns the element at index i, where the mappings are XYZW (X=0, Y=1 etc).
+// Unlike direct indexing, this will panic with a descriptive message if i
+// is not in the range [0,3).
+func (v Vec3) At(i int) float64 {
+	if i < 0 || i >= 3 {
+		panic("Vec3.At: index out of range [0,3)")
+	}
+	return v[i]
+}
+
+// Set sets the element at index i to val. This has a pointer receiver because
+// it mutates the vector. It will panic if i is not in the range [0,3).
+func (v *Vec3) Set(i int, val float64) {
+	if i < 0 || i >= 3 {
+		panic("Vec3.Set: index out of range [0,3)")
+	}
+	v[i] = val
+}
+
 // Does the vector outer product
 // of two vectors. The outer product produces an
 // 3x2 matrix. E.G. a Vec3 * Vec2 = Mat3x2.
@@ -440,6 +773,38 @@ func (v1 Vec4) Len() float64 {
 
 }
 
+// LenManhattan returns the vector's Manhattan (taxicab, or L1) length, the sum
+// of the absolute values of its elements.
+func (v1 Vec4) LenManhattan() float64 {
+	return Abs(v1[0]) + Abs(v1[1]) + Abs(v1[2]) + Abs(v1[3])
+}
+
+// LenChebyshev returns the vector's Chebyshev (chessboard, or L-infinity) length,
+// the largest absolute value of its elements.
+func (v1 Vec4) LenChebyshev() float64 {
+	l := Abs(v1[0])
+	for _, e := range v1[1:] {
+		if Abs(e) > l {
+			l = Abs(e)
+		}
+	}
+	return l
+}
+
+// DistanceManhattan returns the Manhattan distance between v1 and v2, the
+// number of unit steps along the axes needed to get from one to the other.
+// It is equivalent to v1.Sub(v2).LenManhattan().
+func (v1 Vec4) DistanceManhattan(v2 Vec4) float64 {
+	return v1.Sub(v2).LenManhattan()
+}
+
+// DistanceChebyshev returns the Chebyshev distance between v1 and v2, the
+// largest difference along any single axis. It is equivalent to
+// v1.Sub(v2).LenChebyshev().
+func (v1 Vec4) DistanceChebyshev(v2 Vec4) float64 {
+	return v1.Sub(v2).LenChebyshev()
+}
+
 // Normalize normalizes the vector. Normalization is (1/|v|)*v,
 // making this equivalent to v.Scale(1/v.Len()). If the len is 0.0,
 // this function will return an infinite value for all elements due
@@ -487,6 +852,18 @@ func (v1 Vec4) ApproxFuncEqual(v2 Vec4, eq func(float64, float64) bool) bool {
 	return true
 }
 
+// EqualThreshold reports whether every element of v1 is within eps of the corresponding
+// element of v2. Unlike ApproxEqualThreshold this is an absolute tolerance, so it behaves
+// predictably for elements that are at or near zero.
+func (v1 Vec4) EqualThreshold(v2 Vec4, eps float64) bool {
+	for i := range v1 {
+		if Abs(v1[i]-v2[i]) > eps {
+			return false
+		}
+	}
+	return true
+}
+
 // This is an element access func, it is equivalent to v[n] where
 // n is some valid index. The mappings are XYZW (X=0, Y=1 etc). Benchmarks
 // show that this is more or less as fast as direct acces, probably due to
@@ -519,6 +896,49 @@ func (v Vec4) W() float64 {
 	return v[3]
 }
 
+// MulAdd returns v*s + addend, computing each element with a single fused
+// multiply-add (math.FMA) so that the product is not rounded before the addition.
+// This is both more convenient and more accurate than v.Mul(s).Add(addend) in
+// accumulation loops.
+func (v Vec4) MulAdd(s float64, addend Vec4) Vec4 {
+	return Vec4{
+		float64(math.FMA(float64(v[0]), float64(s), float64(addend[0]))),
+		float64(math.FMA(float64(v[1]), float64(s), float64(addend[1]))),
+		float64(math.FMA(float64(v[2]), float64(s), float64(addend[2]))),
+		float64(math.FMA(float64(v[3]), float64(s), float64(addend[3]))),
+	}
+}
+
+// Saturate clamps every element of the vector to the range [0,1], as if
+// Saturate had been called on each one.
+func (v Vec4) Saturate() Vec4 {
+	return Vec4{Saturate(v[0]), Saturate(v[1]), Saturate(v[2]), Saturate(v[3])}
+}
+
+// N returns the number of elements in the vector, i.e. 4.
+func (v Vec4) N() int {
+	return 4
+}
+
+// At returns the element at index i, where the mappings are XYZW (X=0, Y=1 etc).
+// Unlike direct indexing, this will panic with a descriptive message if i
+// is not in the range [0,4).
+func (v Vec4) At(i int) float64 {
+	if i < 0 || i >= 4 {
+		panic("Vec4.At: index out of range [0,4)")
+	}
+	return v[i]
+}
+
+// Set sets the element at index i to val. This has a pointer receiver because
+// it mutates the vector. It will panic if i is not in the range [0,4).
+func (v *Vec4) Set(i int, val float64) {
+	if i < 0 || i >= 4 {
+		panic("Vec4.Set: index out of range [0,4)")
+	}
+	v[i] = val
+}
+
 // Does the vector outer product
 // of two vectors. The outer product produces an
 // 4x2 matrix. E.G. a Vec4 * Vec2 = Mat4x2.